@@ -10,6 +10,7 @@ import (
 
 	"github.com/tinybit/go-pretty/v6/text"
 	"golang.org/x/term"
+	"golang.org/x/text/collate"
 )
 
 var (
@@ -43,6 +44,7 @@ type Progress struct {
 	renderInProgress         bool
 	renderInProgressMutex    sync.RWMutex
 	sortBy                   SortBy
+	sortCollator             *collate.Collator
 	style                    *Style
 	terminalWidth            int
 	terminalWidthMutex       sync.RWMutex
@@ -218,6 +220,18 @@ func (p *Progress) SetSortBy(sortBy SortBy) {
 	p.sortBy = sortBy
 }
 
+// SetSortCollator sets the Collator to use to compare the Messages of the
+// Trackers when sorting them using SortByMessage or SortByMessageDsc. This
+// helps sort accented and non-Latin text as per the rules of a language. It is
+// not a part of the Writer interface, so use it on the Progress returned by
+// NewWriter. For ex.:
+//
+//	pw := progress.NewWriter()
+//	pw.(*progress.Progress).SetSortCollator(collate.New(language.Japanese))
+func (p *Progress) SetSortCollator(collator *collate.Collator) {
+	p.sortCollator = collator
+}
+
 // SetStyle sets the Style to use for rendering.
 func (p *Progress) SetStyle(style Style) {
 	p.style = &style
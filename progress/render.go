@@ -101,8 +101,8 @@ func (p *Progress) extractDoneAndActiveTrackers() ([]*Tracker, []*Tracker) {
 		}
 	}
 	p.trackersActiveMutex.RUnlock()
-	p.sortBy.Sort(trackersDone)
-	p.sortBy.Sort(trackersActive)
+	p.sortBy.SortWithCollator(trackersDone, p.sortCollator)
+	p.sortBy.SortWithCollator(trackersActive, p.sortCollator)
 
 	// calculate the overall tracker's progress value
 	p.overallTracker.value = int64(lengthDone+len(trackersDone)) * 100
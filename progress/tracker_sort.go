@@ -1,6 +1,10 @@
 package progress
 
-import "sort"
+import (
+	"sort"
+
+	"golang.org/x/text/collate"
+)
 
 // SortBy helps sort a list of Trackers by various means.
 type SortBy int
@@ -30,11 +34,18 @@ const (
 
 // Sort applies the sorting method defined by SortBy.
 func (sb SortBy) Sort(trackers []*Tracker) {
+	sb.SortWithCollator(trackers, nil)
+}
+
+// SortWithCollator applies the sorting method defined by SortBy, comparing the
+// Messages as per the rules of the language of the Collator (if not nil)
+// instead of byte-wise.
+func (sb SortBy) SortWithCollator(trackers []*Tracker, collator *collate.Collator) {
 	switch sb {
 	case SortByMessage:
-		sort.Sort(sortByMessage(trackers))
+		sort.Sort(sortByMessage{trackers: trackers, collator: collator})
 	case SortByMessageDsc:
-		sort.Sort(sortDsc{sortByMessage(trackers)})
+		sort.Sort(sortDsc{sortByMessage{trackers: trackers, collator: collator}})
 	case SortByPercent:
 		sort.Sort(sortByPercent(trackers))
 	case SortByPercentDsc:
@@ -48,11 +59,21 @@ func (sb SortBy) Sort(trackers []*Tracker) {
 	}
 }
 
-type sortByMessage []*Tracker
+type sortByMessage struct {
+	trackers []*Tracker
+	collator *collate.Collator
+}
 
-func (sb sortByMessage) Len() int           { return len(sb) }
-func (sb sortByMessage) Swap(i, j int)      { sb[i], sb[j] = sb[j], sb[i] }
-func (sb sortByMessage) Less(i, j int) bool { return sb[i].message() < sb[j].message() }
+func (sb sortByMessage) Len() int { return len(sb.trackers) }
+func (sb sortByMessage) Swap(i, j int) {
+	sb.trackers[i], sb.trackers[j] = sb.trackers[j], sb.trackers[i]
+}
+func (sb sortByMessage) Less(i, j int) bool {
+	if sb.collator != nil {
+		return sb.collator.CompareString(sb.trackers[i].message(), sb.trackers[j].message()) < 0
+	}
+	return sb.trackers[i].message() < sb.trackers[j].message()
+}
 
 type sortByPercent []*Tracker
 
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
 )
 
 func TestSortBy(t *testing.T) {
@@ -57,3 +59,28 @@ func TestSortBy(t *testing.T) {
 	assert.Equal(t, "Downloading File # 2", trackers[2].Message)
 	assert.Equal(t, "Downloading File # 1", trackers[3].Message)
 }
+
+func TestSortBy_SortWithCollator(t *testing.T) {
+	trackers := []*Tracker{
+		{Message: "Zebra"},
+		{Message: "Äpfel"},
+		{Message: "apple"},
+		{Message: "Birne"},
+	}
+	messages := func() []string {
+		var rsp []string
+		for _, tracker := range trackers {
+			rsp = append(rsp, tracker.Message)
+		}
+		return rsp
+	}
+
+	SortByMessage.Sort(trackers)
+	assert.Equal(t, []string{"Birne", "Zebra", "apple", "Äpfel"}, messages())
+
+	SortByMessage.SortWithCollator(trackers, collate.New(language.German))
+	assert.Equal(t, []string{"Äpfel", "apple", "Birne", "Zebra"}, messages())
+
+	SortByMessageDsc.SortWithCollator(trackers, collate.New(language.German))
+	assert.Equal(t, []string{"Zebra", "Birne", "apple", "Äpfel"}, messages())
+}
@@ -0,0 +1,194 @@
+package table
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// AggregateFunc computes a summary value (like a sum or an average) from all
+// the raw values in a column. Values that are covered by a Cell spanning
+// multiple rows are not included.
+type AggregateFunc func(values []interface{}) interface{}
+
+// Aggregate defines a column to summarize (Column Name or Number), and how to
+// summarize it (Func).
+type Aggregate struct {
+	// Name is the name of the Column as it appears in the first Header row.
+	// If a Header is not provided, or the name is not found in the header, this
+	// will not work.
+	Name string
+	// Number is the Column # from left. When specified, it overrides the Name
+	// property. If you know the exact Column number, use this instead of Name.
+	Number int
+
+	// Func computes the summary value; for ex.: AggregateSum.
+	Func AggregateFunc
+}
+
+// AggregateAvg returns the average (as a float64) of all the numeric values,
+// or nil if there are none.
+func AggregateAvg(values []interface{}) interface{} {
+	sum, count := 0.0, 0
+	for _, value := range values {
+		if num, ok := aggregateNumber(value); ok {
+			sum += num
+			count++
+		}
+	}
+	if count == 0 {
+		return nil
+	}
+	return sum / float64(count)
+}
+
+// AggregateCount returns the number of non-empty values.
+func AggregateCount(values []interface{}) interface{} {
+	count := 0
+	for _, value := range values {
+		if value != nil && value != "" {
+			count++
+		}
+	}
+	return count
+}
+
+// AggregateCountDistinct returns the number of distinct non-empty values, with
+// the values being compared in their string form.
+func AggregateCountDistinct(values []interface{}) interface{} {
+	distinct := make(map[string]bool)
+	for _, value := range values {
+		if value != nil && value != "" {
+			distinct[convertValueToString(value)] = true
+		}
+	}
+	return len(distinct)
+}
+
+// AggregateMax returns the largest of all the numeric values, or the largest
+// of all the values in string form if none of them are numeric.
+func AggregateMax(values []interface{}) interface{} {
+	return aggregateExtreme(values, func(a float64, b float64) bool { return a > b },
+		func(a string, b string) bool { return a > b })
+}
+
+// AggregateMin returns the smallest of all the numeric values, or the smallest
+// of all the values in string form if none of them are numeric.
+func AggregateMin(values []interface{}) interface{} {
+	return aggregateExtreme(values, func(a float64, b float64) bool { return a < b },
+		func(a string, b string) bool { return a < b })
+}
+
+// AggregateSum returns the sum of all the numeric values; as an int (or as an
+// int64 if too big for an int) if all of them are integers and the sum fits in
+// an int64, and as a float64 otherwise. It returns nil if there are no numeric
+// values.
+func AggregateSum(values []interface{}) interface{} {
+	sum, sumInt, count, allInts := 0.0, int64(0), 0, true
+	for _, value := range values {
+		num, ok := aggregateNumber(value)
+		if !ok {
+			continue
+		}
+		// add up the integers as such, as float64 cannot hold the ones above
+		// 2^53 exactly
+		if i, isInt := aggregateInteger(value); isInt && allInts && !aggregateSumOverflows(sumInt, i) {
+			sumInt += i
+		} else {
+			allInts = false
+		}
+		sum += num
+		count++
+	}
+	if count == 0 {
+		return nil
+	} else if allInts && int64(int(sumInt)) == sumInt {
+		return int(sumInt)
+	} else if allInts {
+		return sumInt
+	}
+	return sum
+}
+
+// aggregateSumOverflows returns true if adding the given integers overflows.
+func aggregateSumOverflows(a int64, b int64) bool {
+	return (b > 0 && a > math.MaxInt64-b) || (b < 0 && a < math.MinInt64-b)
+}
+
+func aggregateExtreme(values []interface{}, betterNum func(a float64, b float64) bool, betterStr func(a string, b string) bool) interface{} {
+	var rsp interface{}
+	var rspNum float64
+	for _, value := range values {
+		if num, ok := aggregateNumber(value); ok && (rsp == nil || betterNum(num, rspNum)) {
+			rsp, rspNum = value, num
+		}
+	}
+	if rsp != nil {
+		return rsp
+	}
+
+	var rspStr string
+	for _, value := range values {
+		if value == nil || value == "" {
+			continue
+		}
+		if str := convertValueToString(value); rsp == nil || betterStr(str, rspStr) {
+			rsp, rspStr = value, str
+		}
+	}
+	return rsp
+}
+
+// aggregateInteger returns the given value as an int64 if it is an integer
+// (that fits in one) or a string representation of one.
+func aggregateInteger(value interface{}) (int64, bool) {
+	if value == nil {
+		return 0, false
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if rv.Uint() <= math.MaxInt64 {
+			return int64(rv.Uint()), true
+		}
+	case reflect.String:
+		str := strings.TrimSpace(rv.String())
+		if !csvIsNumber(str) {
+			break
+		}
+		if i, err := strconv.ParseInt(str, 10, 64); err == nil {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// aggregateNumber returns the given value as a float64 if it is a number or a
+// string representation of one.
+func aggregateNumber(value interface{}) (float64, bool) {
+	if value == nil {
+		return 0, false
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	case reflect.String:
+		// strings like "007" are identifiers more likely than not
+		str := strings.TrimSpace(rv.String())
+		if !csvIsNumber(str) {
+			break
+		}
+		if f, err := strconv.ParseFloat(str, 64); err == nil {
+			return f, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,114 @@
+package table
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregateAvg(t *testing.T) {
+	assert.Nil(t, AggregateAvg(nil))
+	assert.Nil(t, AggregateAvg([]interface{}{"Arya", nil}))
+	assert.Equal(t, 2.5, AggregateAvg([]interface{}{1, 2.0, "3", uint8(4), "Jon", nil}))
+}
+
+func TestAggregateCount(t *testing.T) {
+	assert.Equal(t, 0, AggregateCount(nil))
+	assert.Equal(t, 3, AggregateCount([]interface{}{1, "Arya", "", nil, false}))
+}
+
+func TestAggregateMax(t *testing.T) {
+	assert.Nil(t, AggregateMax(nil))
+	assert.Equal(t, 3000.5, AggregateMax([]interface{}{2000, 3000.5, "Jon", nil, int64(-5000)}))
+	assert.Equal(t, "Tyrion", AggregateMax([]interface{}{"Arya", "Tyrion", "", nil, "Jon"}))
+}
+
+func TestAggregateMin(t *testing.T) {
+	assert.Nil(t, AggregateMin(nil))
+	assert.Equal(t, int64(-5000), AggregateMin([]interface{}{2000, 3000.5, "Jon", nil, int64(-5000)}))
+	assert.Equal(t, "Arya", AggregateMin([]interface{}{"Jon", "Tyrion", "", nil, "Arya"}))
+}
+
+func TestAggregateSum(t *testing.T) {
+	assert.Nil(t, AggregateSum(nil))
+	assert.Nil(t, AggregateSum([]interface{}{"Arya", nil}))
+	assert.Equal(t, 6000, AggregateSum([]interface{}{3000, int8(-2), uint(2), "1000", "Jon", 2000, nil}))
+	assert.Equal(t, 6000.5, AggregateSum([]interface{}{3000, 1000.5, "2000"}))
+	assert.Equal(t, 1.5, AggregateSum([]interface{}{"1.5", "007"}))
+
+	// integers above 2^53 are added up exactly, and float64 is used if the
+	// sum does not fit in an int64
+	assert.Equal(t, 9007199254740993, AggregateSum([]interface{}{int64(9007199254740992), 1}))
+	assert.Equal(t, 9007199254740993, AggregateSum([]interface{}{uint64(9007199254740991), "2"}))
+	assert.Equal(t, float64(math.MaxUint64), AggregateSum([]interface{}{uint64(math.MaxUint64)}))
+	assert.Equal(t, 2*float64(math.MaxInt64), AggregateSum([]interface{}{int64(math.MaxInt64), int64(math.MaxInt64)}))
+}
+
+func TestAggregateCountDistinct(t *testing.T) {
+	assert.Equal(t, 0, AggregateCountDistinct(nil))
+	assert.Equal(t, 4, AggregateCountDistinct([]interface{}{"Stark", "Snow", "Stark", "", nil, 3000, "3000", 2000}))
+}
+
+func TestTable_Render_FooterAggregates(t *testing.T) {
+	t.Run("with footer", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendHeader(testHeader)
+		tw.AppendRows(testRows)
+		tw.AppendFooter(Row{"", "", "Total", 1234})
+		tw.SetColumnConfigs([]ColumnConfig{
+			{Name: "First Name", Aggregate: AggregateCount},
+			{Name: "Salary", Aggregate: AggregateSum, TransformerFooter: func(val interface{}) string {
+				return fmt.Sprintf("$%v", val)
+			}},
+		})
+
+		compareOutput(t, tw.Render(), `
++-----+------------+-----------+--------+-----------------------------+
+|   # | FIRST NAME | LAST NAME | SALARY |                             |
++-----+------------+-----------+--------+-----------------------------+
+|   1 | Arya       | Stark     |   3000 |                             |
+|  20 | Jon        | Snow      |   2000 | You know nothing, Jon Snow! |
+| 300 | Tyrion     | Lannister |   5000 |                             |
++-----+------------+-----------+--------+-----------------------------+
+|     | 3          | TOTAL     | $10000 |                             |
++-----+------------+-----------+--------+-----------------------------+`)
+
+		tw.FilterBy([]FilterBy{{Name: "Salary", Operator: LessThan, Value: 5000}})
+		compareOutput(t, tw.Render(), `
++----+------------+-----------+--------+-----------------------------+
+|  # | FIRST NAME | LAST NAME | SALARY |                             |
++----+------------+-----------+--------+-----------------------------+
+|  1 | Arya       | Stark     |   3000 |                             |
+| 20 | Jon        | Snow      |   2000 | You know nothing, Jon Snow! |
++----+------------+-----------+--------+-----------------------------+
+|    | 2          | TOTAL     |  $5000 |                             |
++----+------------+-----------+--------+-----------------------------+`)
+	})
+
+	t.Run("without footer", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendHeader(testHeader)
+		tw.AppendRows(testRows)
+		tw.AppendRow(Row{400, "Sansa", "Stark", 1000})
+		tw.SetColumnConfigs([]ColumnConfig{
+			{Name: "Last Name", Aggregate: AggregateCountDistinct},
+			{Number: 4, Aggregate: AggregateAvg, TransformerFooter: func(val interface{}) string {
+				return fmt.Sprintf("%.2f", val)
+			}},
+		})
+
+		compareOutput(t, tw.Render(), `
++-----+------------+-----------+---------+-----------------------------+
+|   # | FIRST NAME | LAST NAME |  SALARY |                             |
++-----+------------+-----------+---------+-----------------------------+
+|   1 | Arya       | Stark     |    3000 |                             |
+|  20 | Jon        | Snow      |    2000 | You know nothing, Jon Snow! |
+| 300 | Tyrion     | Lannister |    5000 |                             |
+| 400 | Sansa      | Stark     |    1000 |                             |
++-----+------------+-----------+---------+-----------------------------+
+|     |            | 3         | 2750.00 |                             |
++-----+------------+-----------+---------+-----------------------------+`)
+	})
+}
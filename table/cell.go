@@ -0,0 +1,185 @@
+package table
+
+// Cell defines a value in a Row that spans one or more columns and/or rows.
+// For example, the following renders "Winterfell" across the last two columns
+// of both rows:
+//
+//	tw.AppendRow(Row{"Arya", table.Cell{Value: "Winterfell", ColSpan: 2, RowSpan: 2}})
+//	tw.AppendRow(Row{"Bran"})
+//
+// Just like in HTML, the cells covered by a Cell are not a part of the Row,
+// and the values that follow are placed in the next available columns. The
+// covered cells are rendered empty when the format does not support merged
+// cells (like CSV or Markdown), and are not merged with the Cell anymore if
+// filtering or sorting separates the rows.
+type Cell struct {
+	ColSpan int         // number of columns to span (defaults to 1)
+	RowSpan int         // number of rows to span (defaults to 1)
+	Value   interface{} // value to render
+}
+
+// String returns the Value of the Cell in string form; this helps FilterBy
+// and the like work on the Value directly.
+func (c Cell) String() string {
+	return convertValueToString(c.Value)
+}
+
+func (c Cell) colSpan() int {
+	if c.ColSpan > 1 {
+		return c.ColSpan
+	}
+	return 1
+}
+
+func (c Cell) rowSpan() int {
+	if c.RowSpan > 1 {
+		return c.RowSpan
+	}
+	return 1
+}
+
+// cellSpan tells how a cell in a Row is a part of a Cell spanning more than one
+// column or row.
+type cellSpan struct {
+	isCovered bool // covered by origin, as opposed to holding its Value?
+	origin    *Cell
+	rowsBelow int // number of rows below this one still covered by origin
+}
+
+// rowCellSpans maps the columns of a Row to the Cells spanning them; it is
+// kept apart from the Row so that the Row has nothing but the values.
+type rowCellSpans map[int]cellSpan
+
+// isCovered returns true if the given column is covered by a Cell spanning
+// multiple columns/rows, and has no value of its own.
+func (rs rowCellSpans) isCovered(colIdx int) bool {
+	return rs[colIdx].isCovered
+}
+
+// cellSpans maps each cell in a section (header/rows/footer) of the Table to
+// the Cell it is a part of (if any).
+type cellSpans [][]*Cell
+
+// areMerged returns true if both the cells are a part of the same Cell.
+func (cs cellSpans) areMerged(rowIdx1 int, colIdx1 int, rowIdx2 int, colIdx2 int) bool {
+	cell := cs.get(rowIdx1, colIdx1)
+	return cell != nil && cell == cs.get(rowIdx2, colIdx2)
+}
+
+// hasRow returns true if the given row has any cells that are a part of a Cell
+// spanning multiple columns/rows.
+func (cs cellSpans) hasRow(rowIdx int) bool {
+	return rowIdx >= 0 && rowIdx < len(cs) && cs[rowIdx] != nil
+}
+
+func (cs cellSpans) get(rowIdx int, colIdx int) *Cell {
+	if rowIdx >= 0 && rowIdx < len(cs) && colIdx >= 0 && colIdx < len(cs[rowIdx]) {
+		return cs[rowIdx][colIdx]
+	}
+	return nil
+}
+
+// expandCellSpans returns the Row with the values of the Cells placed in the
+// columns they begin in, with empty values in all the cells covered by them
+// (including the ones covered by Cells in the rows above as determined by the
+// spans of the previous Row in the same section), and the spans of the Row.
+func expandCellSpans(row Row, spansPrev rowCellSpans) (Row, rowCellSpans) {
+	if !hasCellSpans(row, spansPrev) {
+		return row, nil
+	}
+
+	var rowOut Row
+	spans := make(rowCellSpans)
+	rowOutLen := 0 // length without the trailing fillers
+	for colIdx := 0; len(row) > 0 || colIdx <= spansPrev.getLastColumnCoveringBelow(); colIdx++ {
+		// covered by a Cell in the rows above?
+		if spanPrev, ok := spansPrev[colIdx]; ok && spanPrev.rowsBelow > 0 {
+			rowOut = append(rowOut, "")
+			spans[colIdx] = cellSpan{isCovered: true, origin: spanPrev.origin, rowsBelow: spanPrev.rowsBelow - 1}
+			rowOutLen = len(rowOut)
+			continue
+		}
+		if len(row) == 0 {
+			rowOut = append(rowOut, nil)
+			continue
+		}
+
+		col := row[0]
+		row = row[1:]
+		if cell, ok := col.(Cell); !ok {
+			rowOut = append(rowOut, col)
+		} else if cell.colSpan() == 1 && cell.rowSpan() == 1 {
+			rowOut = append(rowOut, cell.Value)
+		} else {
+			origin := &cell
+			rowOut = append(rowOut, cell.Value)
+			spans[colIdx] = cellSpan{origin: origin, rowsBelow: cell.rowSpan() - 1}
+			for idx := 1; idx < cell.colSpan(); idx++ {
+				rowOut = append(rowOut, "")
+				spans[colIdx+idx] = cellSpan{isCovered: true, origin: origin, rowsBelow: cell.rowSpan() - 1}
+			}
+			colIdx += cell.colSpan() - 1
+		}
+		rowOutLen = len(rowOut)
+	}
+	if len(spans) == 0 {
+		spans = nil
+	}
+	return rowOut[:rowOutLen], spans
+}
+
+// getLastColumnCoveringBelow returns the last column with a cell covering the
+// one below it, or -1 if there are none.
+func (rs rowCellSpans) getLastColumnCoveringBelow() int {
+	lastColIdx := -1
+	for colIdx, span := range rs {
+		if span.rowsBelow > 0 && colIdx > lastColIdx {
+			lastColIdx = colIdx
+		}
+	}
+	return lastColIdx
+}
+
+// hasCellSpans returns true if the Row has any Cells, or if the previous Row
+// has cells covering the ones below them.
+func hasCellSpans(row Row, spansPrev rowCellSpans) bool {
+	for _, col := range row {
+		if _, ok := col.(Cell); ok {
+			return true
+		}
+	}
+	return spansPrev.getLastColumnCoveringBelow() >= 0
+}
+
+// setRowCellSpans records the spans of the given row (if any) in the map of
+// spans by row, and returns the map.
+func setRowCellSpans(spansByRow map[int]rowCellSpans, rowIdx int, spans rowCellSpans) map[int]rowCellSpans {
+	if spans != nil {
+		if spansByRow == nil {
+			spansByRow = make(map[int]rowCellSpans)
+		}
+		spansByRow[rowIdx] = spans
+	}
+	return spansByRow
+}
+
+// getFilteredRowCellSpans returns the spans of the given row in
+// t.rowsRawFiltered (or t.groupRowsRaw following it) if any.
+func (t *Table) getFilteredRowCellSpans(rowIdx int) rowCellSpans {
+	if rowIdx >= 0 && rowIdx < len(t.rowsRawFilteredCellSpans) {
+		return t.rowsRawFilteredCellSpans[rowIdx]
+	}
+	if rowIdx -= len(t.rowsRawFiltered); rowIdx >= 0 && rowIdx < len(t.groupRowsCellSpans) {
+		return t.groupRowsCellSpans[rowIdx]
+	}
+	return nil
+}
+
+// getRawRowCellSpans returns the spans of the raw row for the given index of a
+// row being rendered (after filtering and sorting), like getRawRow.
+func (t *Table) getRawRowCellSpans(rowIdx int) rowCellSpans {
+	if len(t.sortedRowIndices) > 0 {
+		rowIdx = t.sortedRowIndices[rowIdx]
+	}
+	return t.getFilteredRowCellSpans(rowIdx)
+}
@@ -0,0 +1,255 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testCellSpansTable() Writer {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"Name", Cell{Value: "Location", ColSpan: 2}, "House"})
+	tw.AppendRow(Row{"Arya", Cell{Value: "Winterfell", ColSpan: 2, RowSpan: 2}, "Stark"})
+	tw.AppendRow(Row{"Bran", "Stark"})
+	tw.AppendRow(Row{Cell{Value: "Jon", RowSpan: 2}, "Castle Black", "The Wall", "Stark"})
+	tw.AppendRow(Row{"Winterfell", "The North", "Targaryen"})
+	tw.AppendFooter(Row{Cell{Value: "Total", ColSpan: 3}, 4})
+	return tw
+}
+
+func TestCell_String(t *testing.T) {
+	assert.Equal(t, "Winterfell", Cell{Value: "Winterfell", ColSpan: 2}.String())
+	assert.Equal(t, "3000", Cell{Value: 3000}.String())
+}
+
+func TestExpandCellSpans(t *testing.T) {
+	t.Run("no spans", func(t *testing.T) {
+		row := Row{1, "Arya", "Stark"}
+		rowOut, spans := expandCellSpans(row, nil)
+		assert.Equal(t, row, rowOut)
+		assert.Nil(t, spans)
+	})
+
+	t.Run("no-op cell", func(t *testing.T) {
+		rowOut, spans := expandCellSpans(Row{1, Cell{Value: "Arya"}}, nil)
+		assert.Equal(t, Row{1, "Arya"}, rowOut)
+		assert.Nil(t, spans)
+	})
+
+	t.Run("column span", func(t *testing.T) {
+		row, spans := expandCellSpans(Row{1, Cell{Value: "Arya", ColSpan: 3}, 3000}, nil)
+		assert.Equal(t, Row{1, "Arya", "", "", 3000}, row)
+		if assert.Len(t, spans, 3) {
+			origin := spans[1].origin
+			assert.Equal(t, &Cell{Value: "Arya", ColSpan: 3}, origin)
+			assert.Equal(t, rowCellSpans{
+				1: {origin: origin},
+				2: {isCovered: true, origin: origin},
+				3: {isCovered: true, origin: origin},
+			}, spans)
+		}
+	})
+
+	t.Run("row span", func(t *testing.T) {
+		row1, spans1 := expandCellSpans(Row{Cell{Value: "Stark", RowSpan: 3}, "Arya"}, nil)
+		row2, spans2 := expandCellSpans(Row{"Bran"}, spans1)
+		row3, spans3 := expandCellSpans(Row{"Sansa"}, spans2)
+		row4, spans4 := expandCellSpans(Row{"Jon"}, spans3)
+		origin := spans1[0].origin
+		assert.Equal(t, Row{"Stark", "Arya"}, row1)
+		assert.Equal(t, rowCellSpans{0: {origin: origin, rowsBelow: 2}}, spans1)
+		assert.Equal(t, Row{"", "Bran"}, row2)
+		assert.Equal(t, rowCellSpans{0: {isCovered: true, origin: origin, rowsBelow: 1}}, spans2)
+		assert.Equal(t, Row{"", "Sansa"}, row3)
+		assert.Equal(t, rowCellSpans{0: {isCovered: true, origin: origin, rowsBelow: 0}}, spans3)
+		assert.Equal(t, Row{"Jon"}, row4)
+		assert.Nil(t, spans4)
+	})
+
+	t.Run("row span in the middle", func(t *testing.T) {
+		_, spans1 := expandCellSpans(Row{"Arya", Cell{Value: "Stark", RowSpan: 2}}, nil)
+		covered := rowCellSpans{1: {isCovered: true, origin: spans1[1].origin}}
+
+		row2, spans2 := expandCellSpans(Row{"Bran", "Winterfell"}, spans1)
+		assert.Equal(t, Row{"Bran", "", "Winterfell"}, row2)
+		assert.Equal(t, covered, spans2)
+
+		row2, spans2 = expandCellSpans(Row{"Bran"}, spans1)
+		assert.Equal(t, Row{"Bran", ""}, row2)
+		assert.Equal(t, covered, spans2)
+
+		row2, spans2 = expandCellSpans(Row{}, spans1)
+		assert.Equal(t, Row{nil, ""}, row2)
+		assert.Equal(t, covered, spans2)
+	})
+}
+
+func TestTable_CellSpans_RawRows(t *testing.T) {
+	tw := testCellSpansTable()
+	tw.Render()
+
+	// the raw rows have nothing but the values, with the covered cells empty
+	assert.Equal(t, []Row{
+		{"Arya", "Winterfell", "", "Stark"},
+		{"Bran", "", "", "Stark"},
+		{"Jon", "Castle Black", "The Wall", "Stark"},
+		{"", "Winterfell", "The North", "Targaryen"},
+	}, tw.(*Table).rowsRaw)
+	assert.Equal(t, []Row{{"Name", "Location", "", "House"}}, tw.(*Table).rowsHeaderRaw)
+	assert.Equal(t, []Row{{"Total", "", "", 4}}, tw.(*Table).rowsFooterRaw)
+}
+
+func TestTable_Render_CellSpans(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		tw := testCellSpansTable()
+
+		compareOutput(t, tw.Render(), `
++------+--------------------------+-----------+
+| NAME | LOCATION                 | HOUSE     |
++------+--------------------------+-----------+
+| Arya | Winterfell               | Stark     |
+| Bran |                          | Stark     |
+| Jon  | Castle Black | The Wall  | Stark     |
+|      | Winterfell   | The North | Targaryen |
++------+--------------+-----------+-----------+
+| TOTAL                           | 4         |
++---------------------------------+-----------+`)
+	})
+
+	t.Run("separate rows", func(t *testing.T) {
+		tw := testCellSpansTable()
+		tw.SetStyle(StyleLight)
+		tw.Style().Options.SeparateRows = true
+
+		compareOutput(t, tw.Render(), `
+┌──────┬──────────────────────────┬───────────┐
+│ NAME │ LOCATION                 │ HOUSE     │
+├──────┼──────────────────────────┼───────────┤
+│ Arya │ Winterfell               │ Stark     │
+├──────┤                          ├───────────┤
+│ Bran │                          │ Stark     │
+├──────┼──────────────┬───────────┼───────────┤
+│ Jon  │ Castle Black │ The Wall  │ Stark     │
+│      ├──────────────┼───────────┼───────────┤
+│      │ Winterfell   │ The North │ Targaryen │
+├──────┴──────────────┴───────────┼───────────┤
+│ TOTAL                           │ 4         │
+└─────────────────────────────────┴───────────┘`)
+	})
+
+	t.Run("auto index", func(t *testing.T) {
+		tw := testCellSpansTable()
+		tw.SetAutoIndex(true)
+
+		compareOutput(t, tw.Render(), `
++---+------+--------------------------+-----------+
+|   | NAME | LOCATION                 | HOUSE     |
++---+------+--------------------------+-----------+
+| 1 | Arya | Winterfell               | Stark     |
+| 2 | Bran |                          | Stark     |
+| 3 | Jon  | Castle Black | The Wall  | Stark     |
+| 4 |      | Winterfell   | The North | Targaryen |
++---+------+--------------+-----------+-----------+
+|   | TOTAL                           | 4         |
++---+---------------------------------+-----------+`)
+	})
+
+	t.Run("filtered", func(t *testing.T) {
+		tw := testCellSpansTable()
+		tw.FilterBy([]FilterBy{{Number: 1, Operator: NotEqual, Value: "Bran"}})
+
+		compareOutput(t, tw.Render(), `
++------+--------------------------+-----------+
+| NAME | LOCATION                 | HOUSE     |
++------+--------------------------+-----------+
+| Arya | Winterfell               | Stark     |
+| Jon  | Castle Black | The Wall  | Stark     |
+|      | Winterfell   | The North | Targaryen |
++------+--------------+-----------+-----------+
+| TOTAL                           | 4         |
++---------------------------------+-----------+`)
+	})
+
+	t.Run("sorted", func(t *testing.T) {
+		tw := testCellSpansTable()
+		tw.SortBy([]SortBy{{Number: 4, Mode: Dsc}})
+
+		compareOutput(t, tw.Render(), `
++------+--------------------------+-----------+
+| NAME | LOCATION                 | HOUSE     |
++------+--------------+-----------+-----------+
+|      | Winterfell   | The North | Targaryen |
+| Arya | Winterfell               | Stark     |
+| Bran |                          | Stark     |
+| Jon  | Castle Black | The Wall  | Stark     |
++------+--------------+-----------+-----------+
+| TOTAL                           | 4         |
++---------------------------------+-----------+`)
+	})
+}
+
+func TestTable_RenderHTML_CellSpans(t *testing.T) {
+	tw := testCellSpansTable()
+
+	compareOutput(t, tw.RenderHTML(), `
+<table class="go-pretty-table">
+  <thead>
+  <tr>
+    <th>Name</th>
+    <th colspan=2>Location</th>
+    <th>House</th>
+  </tr>
+  </thead>
+  <tbody>
+  <tr>
+    <td>Arya</td>
+    <td colspan=2 rowspan=2>Winterfell</td>
+    <td>Stark</td>
+  </tr>
+  <tr>
+    <td>Bran</td>
+    <td>Stark</td>
+  </tr>
+  <tr>
+    <td rowspan=2>Jon</td>
+    <td>Castle Black</td>
+    <td>The Wall</td>
+    <td>Stark</td>
+  </tr>
+  <tr>
+    <td>Winterfell</td>
+    <td>The North</td>
+    <td>Targaryen</td>
+  </tr>
+  </tbody>
+  <tfoot>
+  <tr>
+    <td colspan=3>Total</td>
+    <td>4</td>
+  </tr>
+  </tfoot>
+</table>`)
+}
+
+func TestTable_RenderMarkdown_CellSpans(t *testing.T) {
+	tw := testCellSpansTable()
+
+	compareOutput(t, tw.RenderMarkdown(), `
+| Name | Location |  | House |
+| --- | --- | --- | --- |
+| Arya | Winterfell |  | Stark |
+| Bran |  |  | Stark |
+| Jon | Castle Black | The Wall | Stark |
+|  | Winterfell | The North | Targaryen |
+| Total |  |  | 4 |`)
+}
+
+func TestTable_RenderJSON_CellSpans(t *testing.T) {
+	tw := testCellSpansTable()
+
+	compareOutput(t, tw.RenderNDJSON(), `
+{"Name":"Arya","Location":"Winterfell","C":"","House":"Stark"}
+{"Name":"Bran","Location":"","C":"","House":"Stark"}
+{"Name":"Jon","Location":"Castle Black","C":"The Wall","House":"Stark"}
+{"Name":"","Location":"Winterfell","C":"The North","House":"Targaryen"}`)
+}
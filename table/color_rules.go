@@ -0,0 +1,154 @@
+package table
+
+import (
+	"math"
+	"regexp"
+
+	"github.com/tinybit/go-pretty/v6/text"
+)
+
+// ColorRule colors the cells of a column with values that match it. Refer to
+// ColorRuleRange, ColorRuleRegexp and ColorRuleFunc for ready-to-use rules.
+type ColorRule struct {
+	// Colors are the colors to use on the matching cells
+	Colors text.Colors
+	// Match returns true if the (raw) value of the cell matches the rule
+	Match func(value interface{}) bool
+}
+
+// ColorRuleFunc returns a ColorRule that colors the cells with values for
+// which the given predicate returns true.
+func ColorRuleFunc(match func(value interface{}) bool, colors text.Colors) ColorRule {
+	return ColorRule{Colors: colors, Match: match}
+}
+
+// ColorRuleRange returns a ColorRule that colors the cells with numeric values
+// (or strings with numbers in them) in the range [min, max). Use math.Inf() for
+// an open-ended range. For example:
+//
+//	ColorRuleRange(math.Inf(-1), 0, text.Colors{text.FgRed})
+func ColorRuleRange(min float64, max float64, colors text.Colors) ColorRule {
+	return ColorRule{
+		Colors: colors,
+		Match: func(value interface{}) bool {
+			number, ok := aggregateNumber(value)
+			return ok && number >= min && number < max
+		},
+	}
+}
+
+// ColorRuleRegexp returns a ColorRule that colors the cells with values that
+// match the given regular expression when converted to a string.
+func ColorRuleRegexp(re *regexp.Regexp, colors text.Colors) ColorRule {
+	return ColorRule{
+		Colors: colors,
+		Match: func(value interface{}) bool {
+			return re.MatchString(convertValueToString(value))
+		},
+	}
+}
+
+// Heatmap colors the numeric cells of a column using a gradient of 256-colors
+// (text.Fg256RGB or text.Bg256RGB) from ColorMin for the smallest value in the
+// column to ColorMax for the largest one; set both to the same color to color
+// all the cells alike. In HTML, the colors are set using "style" attributes.
+type Heatmap struct {
+	// Background colors the background of the cells instead of the text
+	Background bool
+	// ColorMax is the RGB (0-5 each) color for the largest value; defaults to
+	// red if neither ColorMin nor ColorMax is set (i.e., both are [3]int{})
+	ColorMax [3]int
+	// ColorMin is the RGB (0-5 each) color for the smallest value; defaults
+	// to green if neither ColorMin nor ColorMax is set (i.e., both are [3]int{})
+	ColorMin [3]int
+}
+
+// getColors returns the colors for the given value interpolated between the
+// colors for the minimum and the maximum values.
+func (h Heatmap) getColors(value float64, min float64, max float64) text.Colors {
+	colorMin, colorMax := h.ColorMin, h.ColorMax
+	if colorMin == [3]int{} && colorMax == [3]int{} {
+		colorMin, colorMax = [3]int{0, 5, 0}, [3]int{5, 0, 0}
+	}
+
+	fraction := 0.0
+	if max > min {
+		fraction = (value - min) / (max - min)
+	}
+	var rgb [3]int
+	for idx := range rgb {
+		rgb[idx] = colorMin[idx] + int(math.Round(fraction*float64(colorMax[idx]-colorMin[idx])))
+	}
+	if h.Background {
+		return text.Colors{text.Bg256RGB(rgb[0], rgb[1], rgb[2])}
+	}
+	return text.Colors{text.Fg256RGB(rgb[0], rgb[1], rgb[2])}
+}
+
+// initForRenderCellColors finds the colors of the cells in the columns with
+// ColorRules or a Heatmap; indexed by the final position of the row in t.rows
+// and the raw column index.
+func (t *Table) initForRenderCellColors() {
+	t.rowsCellColors = nil
+	for colIdx, cc := range t.columnConfigMap {
+		if len(cc.ColorRules) == 0 && cc.Heatmap == nil {
+			continue
+		}
+		if t.rowsCellColors == nil {
+			t.rowsCellColors = make([]map[int]text.Colors, len(t.rows))
+		}
+
+		// find the range of the values for the heatmap
+		values := make([]interface{}, len(t.rows))
+		min, max := math.Inf(1), math.Inf(-1)
+		for rowIdx := range t.rows {
+			if t.isGroupRow(rowIdx) {
+				continue
+			}
+			if row := t.getRawRow(rowIdx); colIdx < len(row) && !t.getRawRowCellSpans(rowIdx).isCovered(colIdx) {
+				values[rowIdx] = row[colIdx]
+			}
+			if number, ok := aggregateNumber(values[rowIdx]); ok {
+				min, max = math.Min(min, number), math.Max(max, number)
+			}
+		}
+
+		for rowIdx, value := range values {
+			if colors := cc.getCellColors(value, min, max); colors != nil {
+				if t.rowsCellColors[rowIdx] == nil {
+					t.rowsCellColors[rowIdx] = make(map[int]text.Colors)
+				}
+				t.rowsCellColors[rowIdx][colIdx] = colors
+			}
+		}
+	}
+}
+
+// getCellColors returns the colors for the cell as determined by the
+// ColorRules and the Heatmap of the column.
+func (t *Table) getCellColors(colIdx int, hint renderHint) text.Colors {
+	if t.rowsCellColors == nil || hint.isAutoIndexColumn || !hint.isRegularNonSeparatorRow() ||
+		hint.rowNumber < 1 || hint.rowNumber > len(t.rowsCellColors) {
+		return nil
+	}
+	return t.rowsCellColors[hint.rowNumber-1][t.getRawColumnIndex(colIdx)]
+}
+
+// getCellColors returns the colors of the first ColorRule matching the value,
+// or else the colors from the Heatmap (if any).
+func (c ColumnConfig) getCellColors(value interface{}, min float64, max float64) text.Colors {
+	if value == nil {
+		return nil
+	}
+	for _, rule := range c.ColorRules {
+		if rule.Match != nil && rule.Match(value) {
+			return rule.Colors
+		}
+	}
+	if c.Heatmap != nil {
+		if number, ok := aggregateNumber(value); ok {
+			return c.Heatmap.getColors(number, min, max)
+		}
+	}
+	return nil
+}
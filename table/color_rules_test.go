@@ -0,0 +1,188 @@
+package table
+
+import (
+	"math"
+	"regexp"
+	"testing"
+
+	"github.com/tinybit/go-pretty/v6/text"
+)
+
+func TestColorRules(t *testing.T) {
+	newTable := func() Writer {
+		tw := NewWriter()
+		tw.AppendHeader(Row{"Host", "Status", "Load"})
+		tw.AppendRows([]Row{
+			{"db1", "up", 0.25},
+			{"web1", "down", -1},
+			{"web2", "degraded", 0.95},
+			{"web3", "up", "n/a"},
+		})
+		return tw
+	}
+
+	t.Run("rules", func(t *testing.T) {
+		tw := newTable()
+		tw.SetColumnConfigs([]ColumnConfig{
+			{Name: "Status", ColorRules: []ColorRule{
+				ColorRuleRegexp(regexp.MustCompile("^up$"), text.Colors{text.FgGreen}),
+				ColorRuleFunc(func(value interface{}) bool {
+					return value != "up"
+				}, text.Colors{text.FgRed}),
+			}},
+			{Name: "Load", Colors: text.Colors{text.FgBlue}, ColorRules: []ColorRule{
+				ColorRuleRange(math.Inf(-1), 0, text.Colors{text.FgHiBlack}),
+				ColorRuleRange(0.9, math.Inf(1), text.Colors{text.FgRed, text.Bold}),
+			}},
+		})
+
+		compareOutputColored(t, tw.Render(), ""+
+			"+------+----------+------+\n"+
+			"| HOST | STATUS   | LOAD |\n"+
+			"+------+----------+------+\n"+
+			"| db1  |\x1b[32m up       \x1b[0m|\x1b[34m 0.25 \x1b[0m|\n"+
+			"| web1 |\x1b[31m down     \x1b[0m|\x1b[90m -1   \x1b[0m|\n"+
+			"| web2 |\x1b[31m degraded \x1b[0m|\x1b[31;1m 0.95 \x1b[0m|\n"+
+			"| web3 |\x1b[32m up       \x1b[0m|\x1b[34m n/a  \x1b[0m|\n"+
+			"+------+----------+------+")
+	})
+
+	t.Run("rules with row painter", func(t *testing.T) {
+		tw := newTable()
+		tw.SetColumnConfigs([]ColumnConfig{
+			{Name: "Status", ColorRules: []ColorRule{
+				ColorRuleRegexp(regexp.MustCompile("^up$"), text.Colors{text.FgGreen}),
+			}},
+		})
+		tw.SetRowPainter(func(row Row) text.Colors {
+			if row[0] == "db1" {
+				return text.Colors{text.FgYellow}
+			}
+			return nil
+		})
+		tw.SortBy([]SortBy{{Name: "Host", Mode: Dsc}})
+
+		compareOutputColored(t, tw.Render(), ""+
+			"+------+----------+------+\n"+
+			"| HOST | STATUS   | LOAD |\n"+
+			"+------+----------+------+\n"+
+			"| web3 |\x1b[32m up       \x1b[0m| n/a  |\n"+
+			"| web2 | degraded | 0.95 |\n"+
+			"| web1 | down     | -1   |\n"+
+			"|\x1b[33m db1  \x1b[0m|\x1b[33m up       \x1b[0m|\x1b[33m 0.25 \x1b[0m|\n"+
+			"+------+----------+------+")
+	})
+
+	t.Run("heatmap", func(t *testing.T) {
+		tw := newTable()
+		tw.SetColumnConfigs([]ColumnConfig{
+			{Name: "Load", Heatmap: &Heatmap{}},
+		})
+
+		compareOutputColored(t, tw.Render(), ""+
+			"+------+----------+------+\n"+
+			"| HOST | STATUS   | LOAD |\n"+
+			"+------+----------+------+\n"+
+			"| db1  | up       |\x1b[38;5;136m 0.25 \x1b[0m|\n"+
+			"| web1 | down     |\x1b[38;5;46m -1   \x1b[0m|\n"+
+			"| web2 | degraded |\x1b[38;5;196m 0.95 \x1b[0m|\n"+
+			"| web3 | up       | n/a  |\n"+
+			"+------+----------+------+")
+	})
+
+	t.Run("heatmap with custom colors", func(t *testing.T) {
+		tw := newTable()
+		tw.SetColumnConfigs([]ColumnConfig{
+			{Name: "Load", Heatmap: &Heatmap{Background: true, ColorMin: [3]int{0, 0, 5}, ColorMax: [3]int{5, 5, 0}}},
+			{Name: "Status", Hidden: true},
+		})
+
+		compareOutputColored(t, tw.Render(), ""+
+			"+------+------+\n"+
+			"| HOST | LOAD |\n"+
+			"+------+------+\n"+
+			"| db1  |\x1b[48;5;144m 0.25 \x1b[0m|\n"+
+			"| web1 |\x1b[48;5;21m -1   \x1b[0m|\n"+
+			"| web2 |\x1b[48;5;226m 0.95 \x1b[0m|\n"+
+			"| web3 | n/a  |\n"+
+			"+------+------+")
+	})
+
+	t.Run("heatmap with a single color", func(t *testing.T) {
+		tw := newTable()
+		tw.SetColumnConfigs([]ColumnConfig{
+			{Name: "Load", Heatmap: &Heatmap{ColorMin: [3]int{0, 0, 5}, ColorMax: [3]int{0, 0, 5}}},
+		})
+
+		compareOutputColored(t, tw.Render(), ""+
+			"+------+----------+------+\n"+
+			"| HOST | STATUS   | LOAD |\n"+
+			"+------+----------+------+\n"+
+			"| db1  | up       |\x1b[38;5;21m 0.25 \x1b[0m|\n"+
+			"| web1 | down     |\x1b[38;5;21m -1   \x1b[0m|\n"+
+			"| web2 | degraded |\x1b[38;5;21m 0.95 \x1b[0m|\n"+
+			"| web3 | up       | n/a  |\n"+
+			"+------+----------+------+")
+	})
+
+	t.Run("html", func(t *testing.T) {
+		tw := newTable()
+		tw.SetColumnConfigs([]ColumnConfig{
+			{Name: "Status", ColorRules: []ColorRule{
+				ColorRuleRegexp(regexp.MustCompile("^up$"), text.Colors{text.FgGreen}),
+			}},
+			{Name: "Load", Heatmap: &Heatmap{}},
+		})
+
+		compareOutput(t, tw.RenderHTML(), `
+<table class="go-pretty-table">
+  <thead>
+  <tr>
+    <th>Host</th>
+    <th>Status</th>
+    <th>Load</th>
+  </tr>
+  </thead>
+  <tbody>
+  <tr>
+    <td>db1</td>
+    <td class="fg-green">up</td>
+    <td style="color:#996600">0.25</td>
+  </tr>
+  <tr>
+    <td>web1</td>
+    <td>down</td>
+    <td style="color:#00ff00">-1</td>
+  </tr>
+  <tr>
+    <td>web2</td>
+    <td>degraded</td>
+    <td style="color:#ff0000">0.95</td>
+  </tr>
+  <tr>
+    <td>web3</td>
+    <td class="fg-green">up</td>
+    <td>n/a</td>
+  </tr>
+  </tbody>
+</table>`)
+	})
+
+	t.Run("transposed", func(t *testing.T) {
+		tw := newTable()
+		tw.SetColumnConfigs([]ColumnConfig{
+			{Name: "Status", ColorRules: []ColorRule{
+				ColorRuleRegexp(regexp.MustCompile("^up$"), text.Colors{text.FgGreen}),
+			}},
+			{Name: "Load", Heatmap: &Heatmap{}},
+		})
+		tw.Style().Options.Transpose = true
+
+		compareOutputColored(t, tw.Render(), ""+
+			"+--------+------+------+----------+------+\n"+
+			"| HOST   | db1  | web1 | web2     | web3 |\n"+
+			"| STATUS | \x1b[32mup\x1b[0m   | down | degraded | \x1b[32mup\x1b[0m   |\n"+
+			"| LOAD   | \x1b[38;5;136m0.25\x1b[0m | \x1b[38;5;46m-1\x1b[0m   | \x1b[38;5;196m0.95\x1b[0m     | n/a  |\n"+
+			"+--------+------+------+----------+------+")
+	})
+}
@@ -22,6 +22,13 @@ type ColumnConfig struct {
 	// AlignHeader defines the horizontal alignment of Header rows
 	AlignHeader text.Align
 
+	// Aggregate computes a summary value (like AggregateSum) from the values in
+	// the column of all the Rows remaining after filtering, and renders it in
+	// the last Footer row (which gets added if there are none) in place of any
+	// value appended for the column. The value is rendered using
+	// TransformerFooter like any other value in the Footer.
+	Aggregate AggregateFunc
+
 	// AutoMerge merges cells with similar values and prevents separators from
 	// being drawn. Caveats:
 	// * VAlign is applied on the individual cell and not on the merged cell
@@ -33,6 +40,10 @@ type ColumnConfig struct {
 	// * Style().Color.Row == Style().Color.RowAlternate (or not set)
 	AutoMerge bool
 
+	// ColorRules define the colors to be used on the cells of the column with
+	// values matching them (the first matching rule wins); these override
+	// Colors, and are overridden by the colors from the RowPainter.
+	ColorRules []ColorRule
 	// Colors defines the colors to be used on the column
 	Colors text.Colors
 	// ColorsFooter defines the colors to be used on the column in Footer rows
@@ -40,11 +51,22 @@ type ColumnConfig struct {
 	// ColorsHeader defines the colors to be used on the column in Header rows
 	ColorsHeader text.Colors
 
+	// Heatmap colors the numeric cells of the column using a gradient of
+	// colors from the smallest to the largest value in the column, for the
+	// cells not colored by any of the ColorRules.
+	Heatmap *Heatmap
+
 	// Hidden when set to true will prevent the column from being rendered.
 	// This is useful in cases like needing a column for sorting, but not for
 	// display.
 	Hidden bool
 
+	// Priority ranks the column for hiding when the Table does not fit within
+	// Style().Size.WidthMax even after shrinking the WidthShrinkable columns:
+	// the columns with the largest Priority get hidden first (right-most first
+	// among equals), and the columns without a Priority (0) are never hidden.
+	Priority int
+
 	// Transformer is a custom-function that changes the way the value gets
 	// rendered to the console. Refer to text/transformer.go for ready-to-use
 	// Transformer functions.
@@ -68,6 +90,16 @@ type ColumnConfig struct {
 	WidthMaxEnforcer WidthEnforcer
 	// WidthMin defines the minimum character length of the column
 	WidthMin int
+	// WidthWeight is the weight (defaults to 1) of the column when shrinking
+	// all the columns in proportion to their widths to fit the Table within
+	// Style().Size.WidthMax with Style().Size.WidthDistribute; a column with a
+	// weight of 2 gives up half as much of its width as one with a weight of 1.
+	WidthWeight int
+	// WidthShrinkable allows the column to be shrunk (with the contents
+	// wrapped using WidthMaxEnforcer, or text.WrapSoft by default) to fit the
+	// Table within Style().Size.WidthMax; down to WidthMin if set, or else to
+	// the length of the longest word in the column.
+	WidthShrinkable bool
 }
 
 func (c ColumnConfig) getWidthMaxEnforcer() WidthEnforcer {
@@ -0,0 +1,272 @@
+package table
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tinybit/go-pretty/v6/text"
+)
+
+// The markers used in the first column of the Tables generated by Diff to
+// tell how each Row has changed.
+var (
+	DiffMarkerAdded   = "+"
+	DiffMarkerChanged = "~"
+	DiffMarkerRemoved = "-"
+)
+
+// DiffChangeFormat is the format used to show a changed value in the Tables
+// generated by Diff, with the old and the new values as the arguments.
+var DiffChangeFormat = "%s → %s"
+
+// The colors used in the Tables generated by Diff for the added rows, the
+// changed cells and the removed rows. They appear as CSS classes in HTML.
+var (
+	DiffColorsAdded   = text.Colors{text.FgGreen}
+	DiffColorsChanged = text.Colors{text.FgYellow}
+	DiffColorsRemoved = text.Colors{text.FgRed, text.CrossedOut}
+)
+
+// Diff compares the Rows of the Tables from before and after a change, aligned
+// using the values in the key columns, and returns a Table with all of them and
+// a marker in a new first column telling how each Row has changed:
+//
+//	diff := table.Diff(before, after, []string{"Host"})
+//	+---+------+----------+-----------+
+//	|   | HOST | IP       | STATUS    |
+//	+---+------+----------+-----------+
+//	|   | db1  | 10.0.0.1 | up        |
+//	| ~ | web1 | 10.0.0.2 | up → down |
+//	| - | web2 | 10.0.0.3 | up        |
+//	| + | web3 | 10.0.0.4 | up        |
+//	+---+------+----------+-----------+
+//
+// The added Rows are rendered in DiffColorsAdded, the removed Rows in
+// DiffColorsRemoved, and the changed cells show both the values using
+// DiffChangeFormat in DiffColorsChanged. The colors become CSS classes in
+// HTML, and the removed values are struck through in Markdown.
+//
+// The columns are identified by their names in the first Header row of each
+// Table, and the ones found only in before are placed at the end. The Rows are
+// in the order of after, with each removed Row placed after the Row that
+// preceded it in before. All the Rows are considered (FilterBy is not applied),
+// and the Rows with the same key are matched in the order they appear.
+//
+// An empty Table is returned if either Table was not created using NewWriter,
+// or if any of the key columns cannot be found in both of them.
+func Diff(before, after Writer, key []string) Writer {
+	tw := NewWriter()
+	oldTable, ok1 := before.(*Table)
+	newTable, ok2 := after.(*Table)
+	if !ok1 || !ok2 || len(oldTable.rowsHeaderRaw) == 0 || len(newTable.rowsHeaderRaw) == 0 || len(key) == 0 {
+		return tw
+	}
+
+	// find the key columns, and line up the columns of both the Tables
+	oldKeyColIdx, ok1 := pivotGetColumnIndices(oldTable.rowsHeaderRaw[0], key)
+	newKeyColIdx, ok2 := pivotGetColumnIndices(newTable.rowsHeaderRaw[0], key)
+	if !ok1 || !ok2 {
+		return tw
+	}
+	d := diff{tw: tw.(*Table)}
+	d.initColumns(oldTable.rowsHeaderRaw[0], newTable.rowsHeaderRaw[0])
+
+	// match the old Rows to the new ones by their keys
+	oldRowsByKey := make(map[string][]int)
+	for rowIdx, row := range oldTable.rowsRaw {
+		rowKey := diffGetKey(row, oldKeyColIdx)
+		oldRowsByKey[rowKey] = append(oldRowsByKey[rowKey], rowIdx)
+	}
+	oldRowMatched := make([]bool, len(oldTable.rowsRaw))
+	newRowMatches := make([]int, len(newTable.rowsRaw))
+	for rowIdx, row := range newTable.rowsRaw {
+		newRowMatches[rowIdx] = -1
+		rowKey := diffGetKey(row, newKeyColIdx)
+		if oldRowIndices := oldRowsByKey[rowKey]; len(oldRowIndices) > 0 {
+			newRowMatches[rowIdx] = oldRowIndices[0]
+			oldRowMatched[oldRowIndices[0]] = true
+			oldRowsByKey[rowKey] = oldRowIndices[1:]
+		}
+	}
+
+	// append the new Rows, with the removed Rows following the Rows that
+	// preceded them in the old Table
+	oldRowIdxNext := 0
+	appendRemovedRows := func(uptoRowIdx int) {
+		for ; oldRowIdxNext < len(oldTable.rowsRaw) && (oldRowIdxNext < uptoRowIdx || !oldRowMatched[oldRowIdxNext]); oldRowIdxNext++ {
+			if !oldRowMatched[oldRowIdxNext] {
+				d.appendRow(DiffMarkerRemoved, oldTable.rowsRaw[oldRowIdxNext], nil)
+			}
+		}
+	}
+	appendRemovedRows(0)
+	for rowIdx, row := range newTable.rowsRaw {
+		if oldRowIdx := newRowMatches[rowIdx]; oldRowIdx >= 0 {
+			appendRemovedRows(oldRowIdx)
+			d.appendRow(DiffMarkerChanged, oldTable.rowsRaw[oldRowIdx], row)
+			if oldRowIdxNext == oldRowIdx {
+				oldRowIdxNext++
+			}
+			appendRemovedRows(oldRowIdxNext)
+		} else {
+			d.appendRow(DiffMarkerAdded, nil, row)
+		}
+	}
+	appendRemovedRows(len(oldTable.rowsRaw))
+
+	d.setColumnConfigs()
+	tw.SetRowPainter(RowPainter(func(row Row) text.Colors {
+		switch row[0] {
+		case DiffMarkerAdded:
+			return DiffColorsAdded
+		case DiffMarkerRemoved:
+			return DiffColorsRemoved
+		}
+		return nil
+	}))
+	return tw
+}
+
+type diff struct {
+	// columns has the name and the index in each Table of every column
+	columns []diffColumn
+	// tw is the Table being generated
+	tw *Table
+}
+
+type diffColumn struct {
+	name       string
+	newColIdx  int // -1 if not in the new Table
+	nonNumeric bool
+	oldColIdx  int // -1 if not in the old Table
+}
+
+// diffCell is a value in a changed or a removed Row; it gets rendered by the
+// Transformer set on each column.
+type diffCell struct {
+	changed bool
+	new     interface{}
+	old     interface{}
+}
+
+// String returns the value as rendered by the Transformer outside of the
+// Table; say when sorted or filtered.
+func (dc diffCell) String() string {
+	if dc.changed {
+		return fmt.Sprintf(DiffChangeFormat, convertValueToString(dc.old), convertValueToString(dc.new))
+	}
+	return convertValueToString(dc.old)
+}
+
+// appendRow appends a Row with the values from the old and/or the new Row. A
+// Row in both the Tables is marked as changed only if any of the values differ.
+func (d *diff) appendRow(marker string, oldRow Row, newRow Row) {
+	row := Row{marker}
+	changed := false
+	for colIdx, column := range d.columns {
+		oldValue, newValue := diffGetValue(oldRow, column.oldColIdx), diffGetValue(newRow, column.newColIdx)
+		value := newValue
+		if newRow == nil {
+			value = diffCell{old: oldValue}
+		} else if oldRow != nil && column.oldColIdx >= 0 && column.newColIdx >= 0 &&
+			convertValueToString(oldValue) != convertValueToString(newValue) {
+			value = diffCell{changed: true, new: newValue, old: oldValue}
+			changed = true
+		}
+		for _, v := range []interface{}{oldValue, newValue} {
+			if !isNumber(v) && convertValueToString(v) != "" {
+				d.columns[colIdx].nonNumeric = true
+			}
+		}
+		row = append(row, value)
+	}
+	if marker == DiffMarkerChanged && !changed {
+		row[0] = ""
+	}
+	d.tw.AppendRow(row)
+}
+
+// initColumns lines up the columns in the new Table followed by the ones found
+// only in the old Table.
+func (d *diff) initColumns(oldHeader Row, newHeader Row) {
+	for colIdx, name := range newHeader {
+		d.columns = append(d.columns, diffColumn{
+			name:      convertValueToString(name),
+			newColIdx: colIdx,
+			oldColIdx: oldHeader.findColumnNumber(convertValueToString(name)) - 1,
+		})
+	}
+	for colIdx, name := range oldHeader {
+		if newHeader.findColumnNumber(convertValueToString(name)) == 0 {
+			d.columns = append(d.columns, diffColumn{
+				name:      convertValueToString(name),
+				newColIdx: -1,
+				oldColIdx: colIdx,
+			})
+		}
+	}
+
+	header := Row{""}
+	for _, column := range d.columns {
+		header = append(header, column.name)
+	}
+	d.tw.AppendHeader(header)
+}
+
+// setColumnConfigs sets up the Transformer to render the changed and the
+// removed values on all the columns, and keeps the numeric columns aligned to
+// the right.
+func (d *diff) setColumnConfigs() {
+	columnConfigs := []ColumnConfig{{Number: 1, Align: text.AlignCenter}}
+	for colIdx := range d.columns {
+		cc := ColumnConfig{Number: colIdx + 2, Transformer: d.transform}
+		if !d.columns[colIdx].nonNumeric {
+			cc.Align = text.AlignRight
+		}
+		columnConfigs = append(columnConfigs, cc)
+	}
+	d.tw.SetColumnConfigs(columnConfigs)
+}
+
+// transform renders a value as suitable for the current render mode.
+func (d *diff) transform(val interface{}) string {
+	dc, ok := val.(diffCell)
+	if !ok {
+		return convertValueToString(val)
+	}
+
+	oldValue, newValue := convertValueToString(dc.old), convertValueToString(dc.new)
+	switch d.tw.renderMode {
+	case renderModeDefault, renderModeHTML:
+		if dc.changed {
+			return DiffColorsChanged.Sprintf(DiffChangeFormat, oldValue, newValue)
+		}
+	case renderModeMarkdown:
+		if oldValue != "" {
+			oldValue = "~~" + oldValue + "~~"
+		}
+	}
+	if dc.changed {
+		return fmt.Sprintf(DiffChangeFormat, oldValue, newValue)
+	}
+	return oldValue
+}
+
+func diffGetKey(row Row, colIndices []int) string {
+	values := pivotGetLabels(row, colIndices)
+	labels := make([]string, len(values))
+	for idx, value := range values {
+		labels[idx] = convertValueToString(value)
+	}
+	return strings.Join(labels, "\x00")
+}
+
+func diffGetValue(row Row, colIdx int) interface{} {
+	if colIdx < 0 || colIdx >= len(row) {
+		return ""
+	}
+	if row[colIdx] != nil {
+		return row[colIdx]
+	}
+	return ""
+}
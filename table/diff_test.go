@@ -0,0 +1,144 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	before := NewWriter()
+	before.AppendHeader(Row{"Host", "IP", "CPUs", "Status"})
+	before.AppendRows([]Row{
+		{"db1", "10.0.0.1", 8, "up"},
+		{"web1", "10.0.0.2", 2, "up"},
+		{"web2", "10.0.0.3", 2, "up"},
+		{"web4", "10.0.0.5", 2, "down"},
+	})
+	after := NewWriter()
+	after.AppendHeader(Row{"Host", "IP", "CPUs", "Status"})
+	after.AppendRows([]Row{
+		{"db1", "10.0.0.1", 8, "up"},
+		{"web1", "10.0.0.2", 4, "down"},
+		{"web3", "10.0.0.4", 2, "up"},
+		{"web4", "10.0.0.5", 2, "down"},
+	})
+
+	t.Run("default", func(t *testing.T) {
+		diff := Diff(before, after, []string{"Host"})
+
+		compareOutputColored(t, diff.Render(), ""+
+			"+---+------+----------+-------+-----------+\n"+
+			"|   | HOST | IP       | CPUS  | STATUS    |\n"+
+			"+---+------+----------+-------+-----------+\n"+
+			"|   | db1  | 10.0.0.1 |     8 | up        |\n"+
+			"| ~ | web1 | 10.0.0.2 | \x1b[33m2 → 4\x1b[0m | \x1b[33mup → down\x1b[0m |\n"+
+			"|\x1b[31;9m - \x1b[0m|\x1b[31;9m web2 \x1b[0m|\x1b[31;9m 10.0.0.3 \x1b[0m|\x1b[31;9m     2 \x1b[0m|\x1b[31;9m up        \x1b[0m|\n"+
+			"|\x1b[32m + \x1b[0m|\x1b[32m web3 \x1b[0m|\x1b[32m 10.0.0.4 \x1b[0m|\x1b[32m     2 \x1b[0m|\x1b[32m up        \x1b[0m|\n"+
+			"|   | web4 | 10.0.0.5 |     2 | down      |\n"+
+			"+---+------+----------+-------+-----------+")
+	})
+
+	t.Run("html", func(t *testing.T) {
+		diff := Diff(before, after, []string{"Host"})
+
+		compareOutput(t, diff.RenderHTML(), `
+<table class="go-pretty-table">
+  <thead>
+  <tr>
+    <th>&nbsp;</th>
+    <th>Host</th>
+    <th>IP</th>
+    <th>CPUs</th>
+    <th>Status</th>
+  </tr>
+  </thead>
+  <tbody>
+  <tr>
+    <td align="center">&nbsp;</td>
+    <td>db1</td>
+    <td>10.0.0.1</td>
+    <td align="right">8</td>
+    <td>up</td>
+  </tr>
+  <tr>
+    <td align="center">~</td>
+    <td>web1</td>
+    <td>10.0.0.2</td>
+    <td align="right"><span class="fg-yellow">2 → 4</span></td>
+    <td><span class="fg-yellow">up → down</span></td>
+  </tr>
+  <tr>
+    <td align="center" class="crossed-out fg-red">-</td>
+    <td class="crossed-out fg-red">web2</td>
+    <td class="crossed-out fg-red">10.0.0.3</td>
+    <td align="right" class="crossed-out fg-red">2</td>
+    <td class="crossed-out fg-red">up</td>
+  </tr>
+  <tr>
+    <td align="center" class="fg-green">+</td>
+    <td class="fg-green">web3</td>
+    <td class="fg-green">10.0.0.4</td>
+    <td align="right" class="fg-green">2</td>
+    <td class="fg-green">up</td>
+  </tr>
+  <tr>
+    <td align="center">&nbsp;</td>
+    <td>web4</td>
+    <td>10.0.0.5</td>
+    <td align="right">2</td>
+    <td>down</td>
+  </tr>
+  </tbody>
+</table>`)
+	})
+
+	t.Run("markdown", func(t *testing.T) {
+		diff := Diff(before, after, []string{"Host"})
+
+		compareOutput(t, diff.RenderMarkdown(), `
+|  | Host | IP | CPUs | Status |
+|:---:| --- | --- | ---:| --- |
+|  | db1 | 10.0.0.1 | 8 | up |
+| ~ | web1 | 10.0.0.2 | ~~2~~ → 4 | ~~up~~ → down |
+| - | ~~web2~~ | ~~10.0.0.3~~ | ~~2~~ | ~~up~~ |
+| + | web3 | 10.0.0.4 | 2 | up |
+|  | web4 | 10.0.0.5 | 2 | down |`)
+	})
+
+	t.Run("columns and keys", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendHeader(Row{"Host", "Zone", "Status", "Owner"})
+		tw.AppendRows([]Row{
+			{"web1", "b", "up", "ops"},
+			{"web1", "a", "up", "ops"},
+		})
+		diff := Diff(before, tw, []string{"Host", "Zone"})
+		assert.Equal(t, 0, diff.Length())
+
+		tw2 := NewWriter()
+		tw2.AppendHeader(Row{"Host", "Zone", "Status"})
+		tw2.AppendRows([]Row{
+			{"web1", "a", "down"},
+			{"web2", "a", "up"},
+		})
+		diff = Diff(tw2, tw, []string{"Host", "Zone"})
+		compareOutputColored(t, diff.Render(), ""+
+			"+---+------+------+-----------+-------+\n"+
+			"|   | HOST | ZONE | STATUS    | OWNER |\n"+
+			"+---+------+------+-----------+-------+\n"+
+			"|\x1b[32m + \x1b[0m|\x1b[32m web1 \x1b[0m|\x1b[32m b    \x1b[0m|\x1b[32m up        \x1b[0m|\x1b[32m ops   \x1b[0m|\n"+
+			"| ~ | web1 | a    | \x1b[33mdown → up\x1b[0m | ops   |\n"+
+			"|\x1b[31;9m - \x1b[0m|\x1b[31;9m web2 \x1b[0m|\x1b[31;9m a    \x1b[0m|\x1b[31;9m up        \x1b[0m|\x1b[31;9m       \x1b[0m|\n"+
+			"+---+------+------+-----------+-------+")
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		diff := Diff(before, struct{ Writer }{}, []string{"Host"})
+		assert.Equal(t, 0, diff.Length())
+		assert.Empty(t, diff.Render())
+
+		diff = Diff(before, after, nil)
+		assert.Equal(t, 0, diff.Length())
+	})
+}
@@ -5,6 +5,9 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
 )
 
 // FilterBy defines what to filter (Column Name or Number), how to filter (Operator),
@@ -29,8 +32,14 @@ type FilterBy struct {
 	Value interface{}
 
 	// IgnoreCase makes string comparisons case-insensitive (only applies to
-	// string-based operators).
+	// string-based operators). Strings are compared after Unicode case folding
+	// ("Straße" matches "STRASSE"), or after lower-casing them as per the rules
+	// of Locale if set.
 	IgnoreCase bool
+	// Locale is the language to use for the case-insensitive comparisons; this
+	// matters only for languages with special casing rules like Turkish, where
+	// "I" is the upper-case form of "ı" and not "i".
+	Locale language.Tag
 
 	// CustomFilter is a function that can be used to filter rows in a custom
 	// manner. Note that:
@@ -42,6 +51,10 @@ type FilterBy struct {
 	//
 	// Use this when the default filtering logic is not sufficient.
 	CustomFilter func(cellValue string) bool
+
+	// caser is the Caser for the case-insensitive comparisons, set up once for
+	// the render
+	caser *cases.Caser
 }
 
 // FilterOperator defines how to filter.
@@ -74,6 +87,119 @@ const (
 	RegexNotMatch
 )
 
+// Filter is a boolean expression that decides whether a Row is to be rendered.
+// It is either a FilterBy (a predicate on a single column) or a combination of
+// other Filters using And, Or and Not. For example, to render the Rows where
+// "status == failed OR (latency > 500 AND region != eu)":
+//
+//	table.Or(
+//		table.FilterBy{Name: "Status", Operator: table.Equal, Value: "failed"},
+//		table.And(
+//			table.FilterBy{Name: "Latency", Operator: table.GreaterThan, Value: 500},
+//			table.FilterBy{Name: "Region", Operator: table.NotEqual, Value: "eu"},
+//		),
+//	)
+//
+// A FilterBy on a column that cannot be found is ignored, just like it is by
+// Table.FilterBy; it is left out of the And, Or or Not it is a part of (and Not
+// of nothing is ignored too). So the same FilterBy rules mean the same whether
+// set using Table.FilterBy or using And with Table.Filter.
+type Filter interface {
+	matchesRaw(t *Table, row Row) bool
+}
+
+// And returns a Filter that matches a Row only if all the given Filters match
+// it; the FilterBy predicates on unknown columns are ignored.
+func And(filters ...Filter) Filter {
+	return filterAnd(filters)
+}
+
+// Not returns a Filter that matches a Row only if the given Filter does not;
+// it is ignored if the given Filter is (say, a FilterBy on an unknown column).
+func Not(filter Filter) Filter {
+	return filterNot{filter: filter}
+}
+
+// Or returns a Filter that matches a Row if any of the given Filters match it;
+// the FilterBy predicates on unknown columns are ignored.
+func Or(filters ...Filter) Filter {
+	return filterOr(filters)
+}
+
+type filterAnd []Filter
+
+func (f filterAnd) matchesRaw(t *Table, row Row) bool {
+	for _, filter := range f {
+		if !filter.matchesRaw(t, row) {
+			return false
+		}
+	}
+	return true
+}
+
+type filterNot struct {
+	filter Filter
+}
+
+func (f filterNot) matchesRaw(t *Table, row Row) bool {
+	return !f.filter.matchesRaw(t, row)
+}
+
+type filterOr []Filter
+
+func (f filterOr) matchesRaw(t *Table, row Row) bool {
+	for _, filter := range f {
+		if filter.matchesRaw(t, row) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f FilterBy) matchesRaw(t *Table, row Row) bool {
+	return t.matchesFilterRaw(row, f)
+}
+
+// parseFilter resolves the columns of all the FilterBy predicates in the given
+// Filter, and drops the ones that refer to unknown columns (as parseFilterBy
+// does) along with the combinations left with nothing in them. Returns nil if
+// there is nothing to filter by.
+func (t *Table) parseFilter(filter Filter) Filter {
+	switch f := filter.(type) {
+	case FilterBy:
+		if parsed := t.parseFilterBy([]FilterBy{f}); len(parsed) > 0 {
+			return parsed[0]
+		}
+	case *FilterBy:
+		if f != nil {
+			return t.parseFilter(*f)
+		}
+	case filterAnd:
+		if parsed := t.parseFilters(f); len(parsed) > 0 {
+			return filterAnd(parsed)
+		}
+	case filterNot:
+		if parsed := t.parseFilter(f.filter); parsed != nil {
+			return filterNot{filter: parsed}
+		}
+	case filterOr:
+		if parsed := t.parseFilters(f); len(parsed) > 0 {
+			return filterOr(parsed)
+		}
+	}
+	return nil
+}
+
+func (t *Table) parseFilters(filters []Filter) []Filter {
+	var resFilters []Filter
+	for _, filter := range filters {
+		if parsed := t.parseFilter(filter); parsed != nil {
+			resFilters = append(resFilters, parsed)
+		}
+	}
+	return resFilters
+}
+
 func (t *Table) parseFilterBy(filterBy []FilterBy) []FilterBy {
 	var resFilterBy []FilterBy
 	for _, filter := range filterBy {
@@ -90,14 +216,23 @@ func (t *Table) parseFilterBy(filterBy []FilterBy) []FilterBy {
 			}
 		}
 		if colNum > 0 {
-			resFilterBy = append(resFilterBy, FilterBy{
+			resFilter := FilterBy{
 				Name:         filter.Name,
 				Number:       colNum,
 				Operator:     filter.Operator,
 				Value:        filter.Value,
 				IgnoreCase:   filter.IgnoreCase,
+				Locale:       filter.Locale,
 				CustomFilter: filter.CustomFilter,
-			})
+			}
+			if filter.IgnoreCase {
+				caser := cases.Fold()
+				if filter.Locale != language.Und {
+					caser = cases.Lower(filter.Locale)
+				}
+				resFilter.caser = &caser
+			}
+			resFilterBy = append(resFilterBy, resFilter)
 		}
 	}
 	return resFilterBy
@@ -134,9 +269,9 @@ func (t *Table) matchesFilterRaw(row Row, filter FilterBy) bool {
 func (t *Table) matchesOperator(cellValue string, filter FilterBy) bool {
 	switch filter.Operator {
 	case Equal:
-		return t.compareEqual(cellValue, filter.Value, filter.IgnoreCase)
+		return t.compareEqual(cellValue, filter)
 	case NotEqual:
-		return !t.compareEqual(cellValue, filter.Value, filter.IgnoreCase)
+		return !t.compareEqual(cellValue, filter)
 	case GreaterThan:
 		return t.compareNumeric(cellValue, filter.Value, func(a, b float64) bool { return a > b })
 	case GreaterThanOrEqual:
@@ -146,28 +281,36 @@ func (t *Table) matchesOperator(cellValue string, filter FilterBy) bool {
 	case LessThanOrEqual:
 		return t.compareNumeric(cellValue, filter.Value, func(a, b float64) bool { return a <= b })
 	case Contains:
-		return t.compareContains(cellValue, filter.Value, filter.IgnoreCase)
+		return t.compareContains(cellValue, filter)
 	case NotContains:
-		return !t.compareContains(cellValue, filter.Value, filter.IgnoreCase)
+		return !t.compareContains(cellValue, filter)
 	case StartsWith:
-		return t.compareStartsWith(cellValue, filter.Value, filter.IgnoreCase)
+		return t.compareStartsWith(cellValue, filter)
 	case EndsWith:
-		return t.compareEndsWith(cellValue, filter.Value, filter.IgnoreCase)
+		return t.compareEndsWith(cellValue, filter)
 	case RegexMatch:
-		return t.compareRegexMatch(cellValue, filter.Value, filter.IgnoreCase)
+		return t.compareRegexMatch(cellValue, filter)
 	case RegexNotMatch:
-		return !t.compareRegexMatch(cellValue, filter.Value, filter.IgnoreCase)
+		return !t.compareRegexMatch(cellValue, filter)
 	default:
 		return false
 	}
 }
 
-func (t *Table) compareEqual(cellValue string, filterValue interface{}, ignoreCase bool) bool {
-	filterStr := fmt.Sprint(filterValue)
-	if ignoreCase {
-		return strings.EqualFold(cellValue, filterStr)
+// getStrings returns the cell value and the filter value in the form to be
+// compared; case-folded if IgnoreCase is set (using the caser set up by
+// parseFilterBy).
+func (f FilterBy) getStrings(cellValue string) (string, string) {
+	filterStr := fmt.Sprint(f.Value)
+	if f.caser == nil {
+		return cellValue, filterStr
 	}
-	return cellValue == filterStr
+	return f.caser.String(cellValue), f.caser.String(filterStr)
+}
+
+func (t *Table) compareEqual(cellValue string, filter FilterBy) bool {
+	cellStr, filterStr := filter.getStrings(cellValue)
+	return cellStr == filterStr
 }
 
 func (t *Table) compareNumeric(cellValue string, filterValue interface{}, compareFunc func(float64, float64) bool) bool {
@@ -205,37 +348,28 @@ func (t *Table) compareNumeric(cellValue string, filterValue interface{}, compar
 	return compareFunc(cellNum, filterNum)
 }
 
-func (t *Table) compareContains(cellValue string, filterValue interface{}, ignoreCase bool) bool {
-	filterStr := fmt.Sprint(filterValue)
-	if ignoreCase {
-		return strings.Contains(strings.ToLower(cellValue), strings.ToLower(filterStr))
-	}
-	return strings.Contains(cellValue, filterStr)
+func (t *Table) compareContains(cellValue string, filter FilterBy) bool {
+	cellStr, filterStr := filter.getStrings(cellValue)
+	return strings.Contains(cellStr, filterStr)
 }
 
-func (t *Table) compareStartsWith(cellValue string, filterValue interface{}, ignoreCase bool) bool {
-	filterStr := fmt.Sprint(filterValue)
-	if ignoreCase {
-		return strings.HasPrefix(strings.ToLower(cellValue), strings.ToLower(filterStr))
-	}
-	return strings.HasPrefix(cellValue, filterStr)
+func (t *Table) compareStartsWith(cellValue string, filter FilterBy) bool {
+	cellStr, filterStr := filter.getStrings(cellValue)
+	return strings.HasPrefix(cellStr, filterStr)
 }
 
-func (t *Table) compareEndsWith(cellValue string, filterValue interface{}, ignoreCase bool) bool {
-	filterStr := fmt.Sprint(filterValue)
-	if ignoreCase {
-		return strings.HasSuffix(strings.ToLower(cellValue), strings.ToLower(filterStr))
-	}
-	return strings.HasSuffix(cellValue, filterStr)
+func (t *Table) compareEndsWith(cellValue string, filter FilterBy) bool {
+	cellStr, filterStr := filter.getStrings(cellValue)
+	return strings.HasSuffix(cellStr, filterStr)
 }
 
-func (t *Table) compareRegexMatch(cellValue string, filterValue interface{}, ignoreCase bool) bool {
-	filterStr := fmt.Sprint(filterValue)
+func (t *Table) compareRegexMatch(cellValue string, filter FilterBy) bool {
+	filterStr := fmt.Sprint(filter.Value)
 
 	// Compile the regex pattern
 	var pattern *regexp.Regexp
 	var err error
-	if ignoreCase {
+	if filter.IgnoreCase {
 		pattern, err = regexp.Compile("(?i)" + filterStr)
 	} else {
 		pattern, err = regexp.Compile(filterStr)
@@ -243,7 +377,7 @@ func (t *Table) compareRegexMatch(cellValue string, filterValue interface{}, ign
 
 	if err != nil {
 		// If regex compilation fails, fall back to simple string matching
-		return t.compareEqual(cellValue, filterValue, ignoreCase)
+		return t.compareEqual(cellValue, filter)
 	}
 
 	return pattern.MatchString(cellValue)
@@ -0,0 +1,467 @@
+package table
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ErrFilterSyntax is the error wrapped by the FilterSyntaxError returned by
+// Table.ParseFilter for invalid expressions.
+var ErrFilterSyntax = errors.New("invalid filter expression")
+
+// FilterSyntaxError describes a problem with an expression given to
+// Table.ParseFilter, and where in the expression it was found.
+type FilterSyntaxError struct {
+	// Expr is the expression being parsed.
+	Expr string
+	// Offset is the byte offset in Expr where the problem was found.
+	Offset int
+	// Msg describes the problem.
+	Msg string
+}
+
+// Error returns the description of the error along with the position (1-based)
+// of the problem in the expression.
+func (e *FilterSyntaxError) Error() string {
+	return fmt.Sprintf("%s: %s at position %d", ErrFilterSyntax, e.Msg, e.Offset+1)
+}
+
+// Unwrap returns ErrFilterSyntax so that errors.Is works as expected.
+func (e *FilterSyntaxError) Unwrap() error {
+	return ErrFilterSyntax
+}
+
+// ParseFilter compiles a textual expression into a Filter for the Table.
+// Example:
+//
+//	filter, err := tw.ParseFilter(`salary > 3000 and name ~ /^A/i`)
+//	if err == nil {
+//		tw.Filter(filter)
+//	}
+//
+// The expression is made up of predicates of the form "<column> <operator>
+// <value>" combined using "and", "or", "not" (or "&&", "||", "!") and
+// parentheses, with "not" binding tighter than "and", and "and" binding
+// tighter than "or".
+//
+// Columns are referred to by their names in the first Header row, quoted using
+// single or double quotes if they contain anything other than letters, digits
+// and underscores. Names that do not match a column exactly are matched
+// ignoring case, and the Header has to be appended before parsing.
+//
+// The supported operators are:
+//   - "==" (or "="), "!="                          => Equal, NotEqual
+//   - ">", ">=", "<", "<="                         => GreaterThan, etc.
+//   - "~", "!~"                                    => RegexMatch, RegexNotMatch
+//   - "contains", "not contains"                   => Contains, NotContains
+//   - "startswith", "endswith"                     => StartsWith, EndsWith
+//
+// Values are numbers, quoted strings (with a backslash escaping the character
+// after it), regular expressions of the form /.../ (with "\/" for a slash
+// within), or bare words. Strings and regular expressions followed by an "i"
+// (like "jon"i or /^a/i) are compared ignoring case.
+//
+// A *FilterSyntaxError is returned if the expression is not valid, including
+// when a column cannot be found or a regular expression does not compile.
+func (t *Table) ParseFilter(expr string) (Filter, error) {
+	p := filterParser{expr: expr}
+	if len(t.rowsHeaderRaw) > 0 {
+		p.header = t.rowsHeaderRaw[0]
+	}
+	if err := p.tokenize(); err != nil {
+		return nil, err
+	}
+	filter, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != filterTokenEOF {
+		return nil, p.errorAt(tok.pos, "unexpected %s", tok.describe())
+	}
+	return filter, nil
+}
+
+type filterTokenKind int
+
+const (
+	filterTokenEOF filterTokenKind = iota
+	filterTokenIdent
+	filterTokenLParen
+	filterTokenNumber
+	filterTokenOperator
+	filterTokenRegex
+	filterTokenRParen
+	filterTokenString
+)
+
+type filterToken struct {
+	kind       filterTokenKind
+	text       string // the operator, or the un-quoted/un-escaped contents
+	ignoreCase bool   // strings and regular expressions followed by an "i"
+	pos        int
+}
+
+func (tok filterToken) describe() string {
+	switch tok.kind {
+	case filterTokenEOF:
+		return "end of expression"
+	case filterTokenLParen, filterTokenRParen, filterTokenOperator:
+		return fmt.Sprintf("%q", tok.text)
+	case filterTokenNumber:
+		return "number " + tok.text
+	case filterTokenRegex:
+		return "regular expression"
+	case filterTokenString:
+		return "string"
+	default:
+		return fmt.Sprintf("word %q", tok.text)
+	}
+}
+
+// isKeyword returns true if the token is the given (case-insensitive) word.
+func (tok filterToken) isKeyword(keyword string) bool {
+	return tok.kind == filterTokenIdent && strings.EqualFold(tok.text, keyword)
+}
+
+// isSymbol returns true if the token is the given operator/symbol.
+func (tok filterToken) isSymbol(symbol string) bool {
+	return tok.kind == filterTokenOperator && tok.text == symbol
+}
+
+var (
+	// filterOperators maps the symbolic operators to FilterOperators; the
+	// longer ones come first so that they get matched first
+	filterOperators = []struct {
+		symbol   string
+		operator FilterOperator
+	}{
+		{"==", Equal},
+		{"!=", NotEqual},
+		{">=", GreaterThanOrEqual},
+		{"<=", LessThanOrEqual},
+		{"!~", RegexNotMatch},
+		{"=", Equal},
+		{">", GreaterThan},
+		{"<", LessThan},
+		{"~", RegexMatch},
+	}
+	// filterOperatorWords maps the word operators to FilterOperators
+	filterOperatorWords = map[string]FilterOperator{
+		"contains":   Contains,
+		"endswith":   EndsWith,
+		"startswith": StartsWith,
+	}
+	filterSymbols = []string{"&&", "||", "!"}
+)
+
+type filterParser struct {
+	expr   string
+	header Row
+	tokens []filterToken
+	idx    int
+}
+
+func (p *filterParser) errorAt(pos int, format string, a ...interface{}) error {
+	return &FilterSyntaxError{Expr: p.expr, Offset: pos, Msg: fmt.Sprintf(format, a...)}
+}
+
+func (p *filterParser) next() filterToken {
+	tok := p.peek()
+	if p.idx < len(p.tokens) {
+		p.idx++
+	}
+	return tok
+}
+
+func (p *filterParser) peek() filterToken {
+	if p.idx < len(p.tokens) {
+		return p.tokens[p.idx]
+	}
+	return filterToken{kind: filterTokenEOF, pos: len(p.expr)}
+}
+
+// parseOr parses: and-expr { ("or" | "||") and-expr }
+func (p *filterParser) parseOr() (Filter, error) {
+	filter, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	filters := []Filter{filter}
+	for tok := p.peek(); tok.isKeyword("or") || tok.isSymbol("||"); tok = p.peek() {
+		p.next()
+		if filter, err = p.parseAnd(); err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	if len(filters) == 1 {
+		return filters[0], nil
+	}
+	return Or(filters...), nil
+}
+
+// parseAnd parses: unary-expr { ("and" | "&&") unary-expr }
+func (p *filterParser) parseAnd() (Filter, error) {
+	filter, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	filters := []Filter{filter}
+	for tok := p.peek(); tok.isKeyword("and") || tok.isSymbol("&&"); tok = p.peek() {
+		p.next()
+		if filter, err = p.parseUnary(); err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	if len(filters) == 1 {
+		return filters[0], nil
+	}
+	return And(filters...), nil
+}
+
+// parseUnary parses: ("not" | "!") unary-expr | "(" or-expr ")" | predicate
+func (p *filterParser) parseUnary() (Filter, error) {
+	tok := p.peek()
+	switch {
+	case tok.isKeyword("not") || tok.isSymbol("!"):
+		p.next()
+		filter, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return Not(filter), nil
+	case tok.kind == filterTokenLParen:
+		p.next()
+		filter, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if tok := p.next(); tok.kind != filterTokenRParen {
+			return nil, p.errorAt(tok.pos, "expected \")\" but found %s", tok.describe())
+		}
+		return filter, nil
+	}
+	return p.parsePredicate()
+}
+
+// parsePredicate parses: column operator value
+func (p *filterParser) parsePredicate() (Filter, error) {
+	tok := p.next()
+	if tok.kind != filterTokenIdent && tok.kind != filterTokenString {
+		return nil, p.errorAt(tok.pos, "expected a column name but found %s", tok.describe())
+	}
+	name, ok := p.findColumnName(tok.text)
+	if !ok {
+		return nil, p.errorAt(tok.pos, "unknown column %q", tok.text)
+	}
+	filter := FilterBy{Name: name}
+
+	tok = p.next()
+	if tok.isKeyword("not") {
+		if tok = p.next(); !tok.isKeyword("contains") {
+			return nil, p.errorAt(tok.pos, "expected \"contains\" but found %s", tok.describe())
+		}
+		filter.Operator = NotContains
+	} else if operator, ok := filterOperatorWords[strings.ToLower(tok.text)]; ok && tok.kind == filterTokenIdent {
+		filter.Operator = operator
+	} else if operator, ok := filterGetOperator(tok.text); ok && tok.kind == filterTokenOperator {
+		filter.Operator = operator
+	} else {
+		return nil, p.errorAt(tok.pos, "expected an operator but found %s", tok.describe())
+	}
+
+	return p.parseValue(filter)
+}
+
+// findColumnName returns the name of the column in the Header matching the
+// given name exactly, or else ignoring case.
+func (p *filterParser) findColumnName(name string) (string, bool) {
+	if colNum := p.header.findColumnNumber(name); colNum > 0 {
+		return name, true
+	}
+	for _, colName := range p.header {
+		if strings.EqualFold(fmt.Sprint(colName), name) {
+			return fmt.Sprint(colName), true
+		}
+	}
+	return "", false
+}
+
+func (p *filterParser) parseValue(filter FilterBy) (Filter, error) {
+	tok := p.next()
+	switch tok.kind {
+	case filterTokenIdent, filterTokenNumber, filterTokenRegex, filterTokenString:
+	default:
+		return nil, p.errorAt(tok.pos, "expected a value but found %s", tok.describe())
+	}
+	isRegexOperator := filter.Operator == RegexMatch || filter.Operator == RegexNotMatch
+	if tok.kind == filterTokenRegex && !isRegexOperator {
+		return nil, p.errorAt(tok.pos, "regular expressions can be used only with \"~\" and \"!~\"")
+	}
+
+	switch filter.Operator {
+	case GreaterThan, GreaterThanOrEqual, LessThan, LessThanOrEqual:
+		if tok.kind != filterTokenNumber {
+			return nil, p.errorAt(tok.pos, "expected a number but found %s", tok.describe())
+		}
+	case RegexMatch, RegexNotMatch:
+		if _, err := regexp.Compile(tok.text); err != nil {
+			return nil, p.errorAt(tok.pos, "invalid regular expression: %v", err)
+		}
+	}
+
+	filter.Value = tok.text
+	if tok.kind == filterTokenNumber {
+		if i, err := strconv.Atoi(tok.text); err == nil {
+			filter.Value = i
+		} else if f, err := strconv.ParseFloat(tok.text, 64); err == nil {
+			filter.Value = f
+		}
+	}
+	filter.IgnoreCase = tok.ignoreCase
+	return filter, nil
+}
+
+func filterGetOperator(symbol string) (FilterOperator, bool) {
+	for _, op := range filterOperators {
+		if op.symbol == symbol {
+			return op.operator, true
+		}
+	}
+	return 0, false
+}
+
+func (p *filterParser) tokenize() error {
+	for pos := 0; pos < len(p.expr); {
+		r, size := utf8.DecodeRuneInString(p.expr[pos:])
+		switch {
+		case unicode.IsSpace(r):
+			pos += size
+		case r == '(':
+			p.tokens = append(p.tokens, filterToken{kind: filterTokenLParen, text: "(", pos: pos})
+			pos++
+		case r == ')':
+			p.tokens = append(p.tokens, filterToken{kind: filterTokenRParen, text: ")", pos: pos})
+			pos++
+		case r == '"' || r == '\'' || r == '/':
+			tok, end, err := p.tokenizeQuoted(pos, r)
+			if err != nil {
+				return err
+			}
+			p.tokens = append(p.tokens, tok)
+			pos = end
+		case filterIsNumberStart(p.expr[pos:]):
+			end := pos + 1
+			for end < len(p.expr) && strings.ContainsRune("0123456789.eE+-", rune(p.expr[end])) {
+				end++
+			}
+			if _, err := strconv.ParseFloat(p.expr[pos:end], 64); err != nil {
+				return p.errorAt(pos, "invalid number %q", p.expr[pos:end])
+			}
+			p.tokens = append(p.tokens, filterToken{kind: filterTokenNumber, text: p.expr[pos:end], pos: pos})
+			pos = end
+		case filterIsWordRune(r):
+			end := pos
+			for end < len(p.expr) {
+				r, size := utf8.DecodeRuneInString(p.expr[end:])
+				if !filterIsWordRune(r) && r != '-' {
+					break
+				}
+				end += size
+			}
+			p.tokens = append(p.tokens, filterToken{kind: filterTokenIdent, text: p.expr[pos:end], pos: pos})
+			pos = end
+		default:
+			symbol := p.tokenizeSymbol(pos)
+			if symbol == "" {
+				return p.errorAt(pos, "unexpected character %q", r)
+			}
+			p.tokens = append(p.tokens, filterToken{kind: filterTokenOperator, text: symbol, pos: pos})
+			pos += len(symbol)
+		}
+	}
+	return nil
+}
+
+// tokenizeQuoted returns the string or regular expression starting at pos with
+// the given quote, along with the position right after it (and its flags).
+func (p *filterParser) tokenizeQuoted(pos int, quote rune) (filterToken, int, error) {
+	tok := filterToken{kind: filterTokenString, pos: pos}
+	if quote == '/' {
+		tok.kind = filterTokenRegex
+	}
+
+	var sb strings.Builder
+	end := pos + 1
+	for {
+		if end >= len(p.expr) {
+			return tok, end, p.errorAt(pos, "unterminated %s", tok.describe())
+		}
+		r, size := utf8.DecodeRuneInString(p.expr[end:])
+		end += size
+		if r == quote {
+			break
+		}
+		if r == '\\' && end < len(p.expr) {
+			escaped, size := utf8.DecodeRuneInString(p.expr[end:])
+			end += size
+			if escaped != quote && tok.kind == filterTokenRegex {
+				// retain escape sequences as is in regular expressions
+				sb.WriteRune(r)
+			}
+			r = escaped
+		}
+		sb.WriteRune(r)
+	}
+	tok.text = sb.String()
+
+	// flags
+	if end < len(p.expr) && p.expr[end] == 'i' {
+		if r, _ := utf8.DecodeRuneInString(p.expr[end+1:]); end+1 == len(p.expr) || !filterIsWordRune(r) {
+			tok.ignoreCase = true
+			end++
+		}
+	}
+	if end < len(p.expr) {
+		if r, _ := utf8.DecodeRuneInString(p.expr[end:]); filterIsWordRune(r) {
+			return tok, end, p.errorAt(end, "unexpected character %q after %s", r, tok.describe())
+		}
+	}
+	return tok, end, nil
+}
+
+func (p *filterParser) tokenizeSymbol(pos int) string {
+	for _, op := range filterOperators {
+		if strings.HasPrefix(p.expr[pos:], op.symbol) {
+			return op.symbol
+		}
+	}
+	for _, symbol := range filterSymbols {
+		if strings.HasPrefix(p.expr[pos:], symbol) {
+			return symbol
+		}
+	}
+	return ""
+}
+
+// filterIsNumberStart returns true if the text starts with a digit, or with a
+// sign or a decimal point followed by one; "eu-west" is a word and not a number.
+func filterIsNumberStart(text string) bool {
+	if strings.HasPrefix(text, "-") {
+		text = text[1:]
+	}
+	if strings.HasPrefix(text, ".") {
+		text = text[1:]
+	}
+	return text != "" && text[0] >= '0' && text[0] <= '9'
+}
+
+func filterIsWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,161 @@
+package table
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFilter(t *testing.T) {
+	newTable := func(header Row) Writer {
+		tw := NewWriter()
+		tw.AppendHeader(header)
+		return tw
+	}
+
+	t.Run("predicates", func(t *testing.T) {
+		tw := newTable(Row{"salary", "name", "First Name", "Ünïcödé", "id", "Salary (USD)", "or", "region"})
+		tests := map[string]FilterBy{
+			`salary > 3000`:                 {Name: "salary", Operator: GreaterThan, Value: 3000},
+			`salary >= -2.5`:                {Name: "salary", Operator: GreaterThanOrEqual, Value: -2.5},
+			`salary < 1e3`:                  {Name: "salary", Operator: LessThan, Value: 1000.0},
+			`salary <= 0`:                   {Name: "salary", Operator: LessThanOrEqual, Value: 0},
+			`name == Jon`:                   {Name: "name", Operator: Equal, Value: "Jon"},
+			`name = "Jon Snow"i`:            {Name: "name", Operator: Equal, Value: "Jon Snow", IgnoreCase: true},
+			`"First Name" != 'Arya'`:        {Name: "First Name", Operator: NotEqual, Value: "Arya"},
+			`name ~ /^A/i`:                  {Name: "name", Operator: RegexMatch, Value: "^A", IgnoreCase: true},
+			`name !~ /a\/b\d/`:              {Name: "name", Operator: RegexNotMatch, Value: `a/b\d`},
+			`name ~ "^Ar"`:                  {Name: "name", Operator: RegexMatch, Value: "^Ar"},
+			`name contains "o\"n"`:          {Name: "name", Operator: Contains, Value: `o"n`},
+			`name NOT CONTAINS on`:          {Name: "name", Operator: NotContains, Value: "on"},
+			`name startsWith Ty`:            {Name: "name", Operator: StartsWith, Value: "Ty"},
+			`name endswith 'on'i`:           {Name: "name", Operator: EndsWith, Value: "on", IgnoreCase: true},
+			`Ünïcödé == 42`:                 {Name: "Ünïcödé", Operator: Equal, Value: 42},
+			`id == 007`:                     {Name: "id", Operator: Equal, Value: 7},
+			`  name   ==   "spaced"   `:     {Name: "name", Operator: Equal, Value: "spaced"},
+			`(((name == "nested")))`:        {Name: "name", Operator: Equal, Value: "nested"},
+			`name == "i"i`:                  {Name: "name", Operator: Equal, Value: "i", IgnoreCase: true},
+			`name == ""`:                    {Name: "name", Operator: Equal, Value: ""},
+			`name == "tab\there"`:           {Name: "name", Operator: Equal, Value: "tabthere"},
+			`name == "back\\slash"`:         {Name: "name", Operator: Equal, Value: `back\slash`},
+			`name ~ /back\\/`:               {Name: "name", Operator: RegexMatch, Value: `back\\`},
+			`name~/x/`:                      {Name: "name", Operator: RegexMatch, Value: "x"},
+			`salary>3000`:                   {Name: "salary", Operator: GreaterThan, Value: 3000},
+			`"Salary (USD)" > .5`:           {Name: "Salary (USD)", Operator: GreaterThan, Value: 0.5},
+			`name == "and"`:                 {Name: "name", Operator: Equal, Value: "and"},
+			`'or' == x`:                     {Name: "or", Operator: Equal, Value: "x"},
+			`name contains "/not a regex/"`: {Name: "name", Operator: Contains, Value: "/not a regex/"},
+			`NAME == Jon`:                   {Name: "name", Operator: Equal, Value: "Jon"},
+			`"first name" == Jon`:           {Name: "First Name", Operator: Equal, Value: "Jon"},
+			`region != eu-west`:             {Name: "region", Operator: NotEqual, Value: "eu-west"},
+			`region == -1`:                  {Name: "region", Operator: Equal, Value: -1},
+			`region == -.5`:                 {Name: "region", Operator: Equal, Value: -0.5},
+		}
+		for expr, expected := range tests {
+			filter, err := tw.ParseFilter(expr)
+			assert.Nil(t, err, expr)
+			assert.Equal(t, expected, filter, expr)
+		}
+	})
+
+	t.Run("boolean", func(t *testing.T) {
+		tw := newTable(Row{"status", "latency", "region", "a", "b", "c", "d"})
+		filter, err := tw.ParseFilter(`status == failed OR (latency > 500 AND NOT region == eu)`)
+		assert.Nil(t, err)
+		assert.Equal(t, Or(
+			FilterBy{Name: "status", Operator: Equal, Value: "failed"},
+			And(
+				FilterBy{Name: "latency", Operator: GreaterThan, Value: 500},
+				Not(FilterBy{Name: "region", Operator: Equal, Value: "eu"}),
+			),
+		), filter)
+
+		filter, err = tw.ParseFilter(`a == 1 || b == 2 && !c == 3 || d == 4`)
+		assert.Nil(t, err)
+		assert.Equal(t, Or(
+			FilterBy{Name: "a", Operator: Equal, Value: 1},
+			And(
+				FilterBy{Name: "b", Operator: Equal, Value: 2},
+				Not(FilterBy{Name: "c", Operator: Equal, Value: 3}),
+			),
+			FilterBy{Name: "d", Operator: Equal, Value: 4},
+		), filter)
+
+		filter, err = tw.ParseFilter(`not not a == 1`)
+		assert.Nil(t, err)
+		assert.Equal(t, Not(Not(FilterBy{Name: "a", Operator: Equal, Value: 1})), filter)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		tw := newTable(Row{"name", "salary"})
+		tests := map[string]string{
+			``:                       "expected a column name but found end of expression at position 1",
+			`name`:                   "expected an operator but found end of expression at position 5",
+			`name ==`:                "expected a value but found end of expression at position 8",
+			`name is Jon`:            `expected an operator but found word "is" at position 6`,
+			`name not in x`:          `expected "contains" but found word "in" at position 10`,
+			`salary > "3000"`:        "expected a number but found string at position 10",
+			`salary > x`:             `expected a number but found word "x" at position 10`,
+			`name == /Jon/`:          `regular expressions can be used only with "~" and "!~" at position 9`,
+			`name ~ /[a-/`:           "invalid regular expression: error parsing regexp: missing closing ]: `[a-` at position 8",
+			`name ~ "(x"`:            "invalid regular expression: error parsing regexp: missing closing ): `(x` at position 8",
+			`name == "Jon`:           "unterminated string at position 9",
+			`name ~ /Jon`:            "unterminated regular expression at position 8",
+			`name ~ /Jon/x`:          `unexpected character 'x' after regular expression at position 13`,
+			`name == "Jon"ix`:        `unexpected character 'i' after string at position 14`,
+			`(name == Jon`:           `expected ")" but found end of expression at position 13`,
+			`name == Jon)`:           `unexpected ")" at position 12`,
+			`name == Jon Snow`:       `unexpected word "Snow" at position 13`,
+			`name == Jon and`:        "expected a column name but found end of expression at position 16",
+			`name == Jon or or`:      `unknown column "or" at position 16`,
+			`salary > 1.2.3`:         `invalid number "1.2.3" at position 10`,
+			`name == Jon ; drop`:     `unexpected character ';' at position 13`,
+			`== Jon`:                 `expected a column name but found "==" at position 1`,
+			`name == ==`:             `expected a value but found "==" at position 9`,
+			`name ! Jon`:             `expected an operator but found "!" at position 6`,
+			`name == (Jon)`:          `expected a value but found "(" at position 9`,
+			`name == Jon and && x`:   `expected a column name but found "&&" at position 17`,
+			`salry > 3000`:           `unknown column "salry" at position 1`,
+			`name == x or "sal" > 1`: `unknown column "sal" at position 14`,
+			`region != eu-west`:      `unknown column "region" at position 1`,
+			`name == -x`:             `unexpected character '-' at position 9`,
+		}
+		for expr, expected := range tests {
+			filter, err := tw.ParseFilter(expr)
+			assert.Nil(t, filter, expr)
+			if assert.NotNil(t, err, expr) {
+				assert.True(t, errors.Is(err, ErrFilterSyntax), expr)
+				assert.Equal(t, "invalid filter expression: "+expected, err.Error(), expr)
+			}
+		}
+
+		_, err := tw.ParseFilter(`name == Jon ; drop`)
+		var syntaxErr *FilterSyntaxError
+		if assert.True(t, errors.As(err, &syntaxErr)) {
+			assert.Equal(t, `name == Jon ; drop`, syntaxErr.Expr)
+			assert.Equal(t, 12, syntaxErr.Offset)
+		}
+
+		_, err = NewWriter().ParseFilter(`name == Jon`)
+		if assert.NotNil(t, err) {
+			assert.Equal(t, `invalid filter expression: unknown column "name" at position 1`, err.Error())
+		}
+	})
+
+	t.Run("render", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendHeader(testHeader)
+		tw.AppendRows(testRows)
+		filter, err := tw.ParseFilter(`salary > 2000 and "first name" ~ /^a|^t/i`)
+		assert.Nil(t, err)
+		tw.Filter(filter)
+		compareOutput(t, tw.Render(), `
++-----+------------+-----------+--------+
+|   # | FIRST NAME | LAST NAME | SALARY |
++-----+------------+-----------+--------+
+|   1 | Arya       | Stark     |   3000 |
+| 300 | Tyrion     | Lannister |   5000 |
++-----+------------+-----------+--------+`)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
 )
 
 func TestTable_FilterRows(t *testing.T) {
@@ -657,3 +658,157 @@ func TestTable_calculateNumColumnsFromRaw(t *testing.T) {
 		assert.Equal(t, 4, table.numColumns)
 	})
 }
+
+func TestTable_Filter(t *testing.T) {
+	newTable := func() *Table {
+		table := &Table{}
+		table.AppendHeader(Row{"Service", "Status", "Latency", "Region"})
+		table.AppendRows([]Row{
+			{"auth", "failed", 100, "us"},
+			{"billing", "ok", 700, "us"},
+			{"search", "ok", 900, "eu"},
+			{"users", "ok", 200, "us"},
+			{"orders", "failed", 800, "eu"},
+		})
+		return table
+	}
+	services := func(table *Table) []interface{} {
+		var rsp []interface{}
+		for _, row := range table.rowsRawFiltered {
+			rsp = append(rsp, row[0])
+		}
+		return rsp
+	}
+
+	t.Run("Or And", func(t *testing.T) {
+		table := newTable()
+		table.Filter(Or(
+			FilterBy{Name: "Status", Operator: Equal, Value: "failed"},
+			And(
+				FilterBy{Name: "Latency", Operator: GreaterThan, Value: 500},
+				FilterBy{Name: "Region", Operator: NotEqual, Value: "eu"},
+			),
+		))
+		table.initForRenderRows()
+		assert.Equal(t, []interface{}{"auth", "billing", "orders"}, services(table))
+	})
+
+	t.Run("Not", func(t *testing.T) {
+		table := newTable()
+		table.Filter(Not(Or(
+			FilterBy{Number: 2, Operator: Equal, Value: "failed"},
+			&FilterBy{Number: 4, Operator: Equal, Value: "eu"},
+		)))
+		table.initForRenderRows()
+		assert.Equal(t, []interface{}{"billing", "users"}, services(table))
+	})
+
+	t.Run("With FilterBy", func(t *testing.T) {
+		table := newTable()
+		table.FilterBy([]FilterBy{{Name: "Region", Operator: Equal, Value: "us"}})
+		table.Filter(Or(
+			FilterBy{Name: "Latency", Operator: LessThan, Value: 150},
+			FilterBy{Name: "Latency", Operator: GreaterThan, Value: 650},
+		))
+		table.initForRenderRows()
+		assert.Equal(t, []interface{}{"auth", "billing"}, services(table))
+	})
+
+	t.Run("Unknown Columns", func(t *testing.T) {
+		// a predicate on an unknown column is ignored, and so is Not of it
+		unknown := FilterBy{Name: "Owner", Operator: Equal, Value: "nobody"}
+		table := newTable()
+		table.Filter(And(
+			Not(unknown),
+			FilterBy{Name: "Status", Operator: Equal, Value: "ok"},
+		))
+		table.initForRenderRows()
+		assert.Equal(t, []interface{}{"billing", "search", "users"}, services(table))
+
+		table.Filter(Or(FilterBy{Name: "Status", Operator: Equal, Value: "failed"}, unknown))
+		table.initForRenderRows()
+		assert.Equal(t, []interface{}{"auth", "orders"}, services(table))
+
+		table.Filter(And(FilterBy{Name: "Status", Operator: Equal, Value: "failed"}, unknown))
+		table.initForRenderRows()
+		assert.Equal(t, []interface{}{"auth", "orders"}, services(table))
+
+		table.Filter(unknown)
+		table.initForRenderRows()
+		assert.Len(t, table.rowsRawFiltered, 5)
+
+		table.Filter(Not(unknown))
+		table.initForRenderRows()
+		assert.Len(t, table.rowsRawFiltered, 5)
+
+		table.Filter(Or(unknown, &unknown))
+		table.initForRenderRows()
+		assert.Len(t, table.rowsRawFiltered, 5)
+	})
+
+	t.Run("Unknown Columns Same As FilterBy", func(t *testing.T) {
+		rules := []FilterBy{
+			{Name: "Status", Operator: Equal, Value: "failed"},
+			{Name: "Owner", Operator: Equal, Value: "nobody"},
+		}
+		tableFilterBy := newTable()
+		tableFilterBy.FilterBy(rules)
+		tableFilterBy.initForRenderRows()
+		assert.Equal(t, []interface{}{"auth", "orders"}, services(tableFilterBy))
+
+		tableFilter := newTable()
+		tableFilter.Filter(And(rules[0], rules[1]))
+		tableFilter.initForRenderRows()
+		assert.Equal(t, services(tableFilterBy), services(tableFilter))
+
+		tableFilterBy.FilterBy(rules[1:])
+		tableFilterBy.initForRenderRows()
+		assert.Len(t, tableFilterBy.rowsRawFiltered, 5)
+
+		tableFilter.Filter(And(rules[1]))
+		tableFilter.initForRenderRows()
+		assert.Len(t, tableFilter.rowsRawFiltered, 5)
+	})
+
+	t.Run("Render", func(t *testing.T) {
+		table := newTable()
+		table.Filter(Not(FilterBy{Name: "Status", Operator: Equal, Value: "ok"}))
+		compareOutput(t, table.Render(), `
++---------+--------+---------+--------+
+| SERVICE | STATUS | LATENCY | REGION |
++---------+--------+---------+--------+
+| auth    | failed |     100 | us     |
+| orders  | failed |     800 | eu     |
++---------+--------+---------+--------+`)
+	})
+}
+
+func TestTable_Filter_IgnoreCaseFolding(t *testing.T) {
+	filterNames := func(filter FilterBy) []interface{} {
+		table := &Table{}
+		table.AppendHeader(Row{"Name"})
+		table.AppendRows([]Row{{"Straße"}, {"ISTANBUL"}, {"İzmir"}, {"ΣΊΣΥΦΟΣ"}})
+		table.FilterBy([]FilterBy{filter})
+		table.initForRenderRows()
+		var rsp []interface{}
+		for _, row := range table.rowsRawFiltered {
+			rsp = append(rsp, row[0])
+		}
+		return rsp
+	}
+
+	assert.Equal(t, []interface{}{"Straße"},
+		filterNames(FilterBy{Name: "Name", Operator: Equal, Value: "STRASSE", IgnoreCase: true}))
+	assert.Equal(t, []interface{}{"ΣΊΣΥΦΟΣ"},
+		filterNames(FilterBy{Name: "Name", Operator: StartsWith, Value: "σίσυφ", IgnoreCase: true}))
+	assert.Equal(t, []interface{}{"ISTANBUL"},
+		filterNames(FilterBy{Name: "Name", Operator: Contains, Value: "istan", IgnoreCase: true}))
+	assert.Empty(t,
+		filterNames(FilterBy{Name: "Name", Operator: Contains, Value: "istan", IgnoreCase: true, Locale: language.Turkish}))
+	assert.Equal(t, []interface{}{"ISTANBUL"},
+		filterNames(FilterBy{Name: "Name", Operator: Contains, Value: "ıstan", IgnoreCase: true, Locale: language.Turkish}))
+	assert.Equal(t, []interface{}{"İzmir"},
+		filterNames(FilterBy{Name: "Name", Operator: EndsWith, Value: "izmir", IgnoreCase: true, Locale: language.Turkish}))
+	assert.Equal(t, []interface{}{"Straße", "ISTANBUL", "İzmir"},
+		filterNames(FilterBy{Name: "Name", Operator: NotContains, Value: "ς", IgnoreCase: true}))
+}
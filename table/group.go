@@ -0,0 +1,311 @@
+package table
+
+import (
+	"fmt"
+
+	"github.com/tinybit/go-pretty/v6/text"
+)
+
+// GroupBy defines What to group the Rows by (Column Name or Number), and How
+// to render each group: with a header row above it, and a subtotal row below
+// it if Aggregates are defined.
+type GroupBy struct {
+	// Name is the name of the Column as it appears in the first Header row.
+	// If a Header is not provided, or the name is not found in the header, this
+	// will not work.
+	Name string
+	// Number is the Column # from left. When specified, it overrides the Name
+	// property. If you know the exact Column number, use this instead of Name.
+	Number int
+
+	// Aggregates defines the columns to summarize in the subtotal row rendered
+	// after each group; no subtotal rows are rendered if this is empty.
+	Aggregates []Aggregate
+	// Collapse hides the Rows in each group leaving behind just the header and
+	// subtotal rows.
+	Collapse bool
+
+	// HeaderColors defines the colors to render the group header row in.
+	HeaderColors text.Colors
+	// HeaderFormat returns the text to render in the group header row given the
+	// value of the column being grouped by and the number of Rows in the group.
+	// Defaults to "<value> (<numRows>)".
+	HeaderFormat func(value string, numRows int) string
+	// HeaderHidden hides the group header rows.
+	HeaderHidden bool
+	// HeaderRowConfig defines the RowConfig to use for the group header rows.
+	HeaderRowConfig RowConfig
+
+	// SubtotalColors defines the colors to render the subtotal row in.
+	SubtotalColors text.Colors
+	// SubtotalLabel is the text to render in the subtotal row under the column
+	// being grouped by (unless it is being aggregated). Defaults to "Subtotal".
+	SubtotalLabel string
+	// SubtotalRowConfig defines the RowConfig to use for the subtotal rows.
+	SubtotalRowConfig RowConfig
+}
+
+func (g GroupBy) getHeaderText(value string, numRows int) string {
+	if g.HeaderFormat != nil {
+		return g.HeaderFormat(value, numRows)
+	}
+	return fmt.Sprintf("%s (%d)", value, numRows)
+}
+
+func (g GroupBy) getSubtotalLabel() string {
+	if g.SubtotalLabel != "" {
+		return g.SubtotalLabel
+	}
+	return "Subtotal"
+}
+
+// groupRow contains information about a row being rendered when the Rows are
+// grouped using GroupBy.
+type groupRow struct {
+	groupBy    *GroupBy // group the header/subtotal row belongs to (nil for regular rows)
+	isGroupEnd bool     // is this the last row of a top-level group?
+	isSubtotal bool     // is this a subtotal row (or a header row)?
+	rowIdx     int      // index of a regular row in t.rows before grouping
+}
+
+// groupedRows is the result of grouping the Rows, in the order they have to be
+// rendered in.
+type groupedRows struct {
+	groupRows     []groupRow
+	rows          []rowStr
+	rowsCellSpans []rowCellSpans
+	rowsRaw       []Row // nil for regular rows
+}
+
+// initForRenderGroupRows groups the (filtered and sorted) rows as directed by
+// Table.groupBy, and inserts the group header and subtotal rows. The groups
+// appear in the order in which their first rows appear.
+func (t *Table) initForRenderGroupRows() {
+	if len(t.groupBy) == 0 || len(t.rows) == 0 {
+		return
+	}
+	groupBy := t.parseGroupBy(t.groupBy)
+	if len(groupBy) == 0 {
+		return
+	}
+
+	rowIndices := make([]int, len(t.rows))
+	for idx := range rowIndices {
+		rowIndices[idx] = idx
+	}
+	var out groupedRows
+	t.groupRowsRecursively(&out, groupBy, rowIndices, true)
+
+	// the raw rows for the group header and subtotal rows are numbered after
+	// the filtered rows so that getRawRow (and the likes of it) work as usual
+	sortedRowIndices := make([]int, len(out.rows))
+	for idx, groupRow := range out.groupRows {
+		if out.rowsRaw[idx] != nil {
+			t.groupRowsCellSpans = append(t.groupRowsCellSpans, out.rowsCellSpans[idx])
+			t.groupRowsRaw = append(t.groupRowsRaw, out.rowsRaw[idx])
+			sortedRowIndices[idx] = len(t.rowsRawFiltered) + len(t.groupRowsRaw) - 1
+		} else if len(t.sortedRowIndices) > 0 {
+			sortedRowIndices[idx] = t.sortedRowIndices[groupRow.rowIdx]
+		} else {
+			sortedRowIndices[idx] = groupRow.rowIdx
+		}
+	}
+	t.groupRows = out.groupRows
+	t.rows = out.rows
+	t.sortedRowIndices = sortedRowIndices
+
+	// auto-index: the group header/subtotal rows get numbered as well
+	t.autoIndexVIndexMaxLength = len(fmt.Sprint(len(t.rows)))
+}
+
+func (t *Table) groupRowsRecursively(out *groupedRows, groupBy []GroupBy, rowIndices []int, isTopLevel bool) {
+	gb := &groupBy[0]
+	colIdx := gb.Number - 1
+
+	// split the rows into groups retaining the order of the rows in each
+	var groupKeys []string
+	groups := make(map[string][]int)
+	for _, rowIdx := range rowIndices {
+		var key string
+		if colIdx < len(t.rows[rowIdx]) {
+			key = t.rows[rowIdx][colIdx]
+		}
+		if _, ok := groups[key]; !ok {
+			groupKeys = append(groupKeys, key)
+		}
+		groups[key] = append(groups[key], rowIdx)
+	}
+
+	for _, key := range groupKeys {
+		group := groups[key]
+		if !gb.HeaderHidden {
+			headerText := gb.getHeaderText(key, len(group))
+			headerRow, headerRowCellSpans := t.groupGetHeaderRow(headerText)
+			out.append(groupRow{groupBy: gb}, headerRow, headerRowCellSpans, t.groupGetHeaderRowStr(headerText))
+		}
+		if gb.Collapse {
+			// render nothing but the header and subtotal rows
+		} else if len(groupBy) > 1 {
+			t.groupRowsRecursively(out, groupBy[1:], group, false)
+		} else {
+			for _, rowIdx := range group {
+				out.append(groupRow{rowIdx: rowIdx}, nil, nil, t.rows[rowIdx])
+			}
+		}
+		if len(gb.Aggregates) > 0 {
+			subtotal := t.groupGetSubtotalRow(gb, group)
+			out.append(groupRow{groupBy: gb, isSubtotal: true}, subtotal, nil, t.groupGetSubtotalRowStr(subtotal))
+		}
+		if isTopLevel && len(out.groupRows) > 0 {
+			out.groupRows[len(out.groupRows)-1].isGroupEnd = true
+		}
+	}
+}
+
+// groupGetHeaderFirstColumn returns the first column that is not hidden; the
+// group header text is placed here to span all the columns after it.
+func (t *Table) groupGetHeaderFirstColumn() int {
+	colIdx := 0
+	for colIdx < t.numColumns-1 && t.columnConfigMap[colIdx].Hidden {
+		colIdx++
+	}
+	return colIdx
+}
+
+func (t *Table) groupGetHeaderRow(headerText string) (Row, rowCellSpans) {
+	colIdx := t.groupGetHeaderFirstColumn()
+	row := make(Row, colIdx, t.numColumns)
+	row = append(row, Cell{Value: headerText, ColSpan: t.numColumns - colIdx})
+	return expandCellSpans(row, nil)
+}
+
+func (t *Table) groupGetHeaderRowStr(headerText string) rowStr {
+	row := make(rowStr, t.numColumns)
+	row[t.groupGetHeaderFirstColumn()] = text.ProcessCRLF(headerText)
+	return row
+}
+
+func (t *Table) groupGetSubtotalRow(gb *GroupBy, rowIndices []int) Row {
+	row := make(Row, t.numColumns)
+	row[gb.Number-1] = gb.getSubtotalLabel()
+	for _, aggregate := range gb.Aggregates {
+		colIdx := aggregate.Number - 1
+		values := make([]interface{}, 0, len(rowIndices))
+		for _, rowIdx := range rowIndices {
+			if len(t.sortedRowIndices) > 0 {
+				rowIdx = t.sortedRowIndices[rowIdx]
+			}
+			if rowRaw := t.rowsRawFiltered[rowIdx]; colIdx < len(rowRaw) && !t.getFilteredRowCellSpans(rowIdx).isCovered(colIdx) {
+				values = append(values, rowRaw[colIdx])
+			}
+		}
+		row[colIdx] = aggregate.Func(values)
+	}
+	return row
+}
+
+func (t *Table) groupGetSubtotalRowStr(row Row) rowStr {
+	rowOut := make(rowStr, len(row))
+	for colIdx, col := range row {
+		if col != nil {
+			rowOut[colIdx] = t.analyzeAndStringifyColumn(colIdx, col, renderHint{})
+		}
+	}
+	return rowOut
+}
+
+func (out *groupedRows) append(groupRow groupRow, rowRaw Row, rowCellSpans rowCellSpans, row rowStr) {
+	out.groupRows = append(out.groupRows, groupRow)
+	out.rows = append(out.rows, row)
+	out.rowsCellSpans = append(out.rowsCellSpans, rowCellSpans)
+	out.rowsRaw = append(out.rowsRaw, rowRaw)
+}
+
+// getGroupRow returns the information about the given regular row if the rows
+// have been grouped, with the groupBy field set only for header/subtotal rows.
+func (t *Table) getGroupRow(rowIdx int) groupRow {
+	if rowIdx >= 0 && rowIdx < len(t.groupRows) {
+		return t.groupRows[rowIdx]
+	}
+	return groupRow{rowIdx: rowIdx}
+}
+
+// getGroupRowColors returns the colors to use for the given regular row if it
+// is a group header/subtotal row.
+func (t *Table) getGroupRowColors(rowIdx int) text.Colors {
+	if groupRow := t.getGroupRow(rowIdx); groupRow.groupBy != nil {
+		if groupRow.isSubtotal {
+			return groupRow.groupBy.SubtotalColors
+		}
+		return groupRow.groupBy.HeaderColors
+	}
+	return nil
+}
+
+// getGroupRowConfig returns the RowConfig to use for the given regular row if
+// it is a group header/subtotal row.
+func (t *Table) getGroupRowConfig(rowIdx int) (RowConfig, bool) {
+	if groupRow := t.getGroupRow(rowIdx); groupRow.groupBy != nil {
+		if groupRow.isSubtotal {
+			return groupRow.groupBy.SubtotalRowConfig, true
+		}
+		return groupRow.groupBy.HeaderRowConfig, true
+	}
+	return RowConfig{}, false
+}
+
+// hasSeparatorAfter returns true if a separator has to be rendered after the
+// given row, either because it was added manually using AppendSeparator, or
+// because it is the last row of a group.
+func (t *Table) hasSeparatorAfter(rowIdx int, hint renderHint) bool {
+	if t.transposed {
+		return false
+	} else if t.groupRows != nil && hint.isRegularRow() {
+		groupRow := t.getGroupRow(rowIdx)
+		return groupRow.isGroupEnd || (groupRow.groupBy == nil && t.separators[groupRow.rowIdx])
+	}
+	return t.separators[rowIdx]
+}
+
+// isGroupRow returns true if the given regular row is a group header/subtotal
+// row.
+func (t *Table) isGroupRow(rowIdx int) bool {
+	return t.getGroupRow(rowIdx).groupBy != nil
+}
+
+func (t *Table) parseGroupBy(groupBy []GroupBy) []GroupBy {
+	var resGroupBy []GroupBy
+	for _, gb := range groupBy {
+		colNum := t.parseColumnNumber(gb.Name, gb.Number)
+		if colNum == 0 {
+			continue
+		}
+		gb.Number = colNum
+
+		var aggregates []Aggregate
+		for _, aggregate := range gb.Aggregates {
+			if aggregate.Number = t.parseColumnNumber(aggregate.Name, aggregate.Number); aggregate.Number > 0 && aggregate.Func != nil {
+				aggregates = append(aggregates, aggregate)
+			}
+		}
+		gb.Aggregates = aggregates
+		resGroupBy = append(resGroupBy, gb)
+	}
+	return resGroupBy
+}
+
+// parseColumnNumber returns the Column # given either the number itself or the
+// name of the column as it appears in the first Header row; 0 if neither is
+// valid.
+func (t *Table) parseColumnNumber(name string, number int) int {
+	if number > 0 && number <= t.numColumns {
+		return number
+	} else if name != "" && len(t.rowsHeader) > 0 {
+		for idx, colName := range t.rowsHeader[0] {
+			if name == colName {
+				return idx + 1
+			}
+		}
+	}
+	return 0
+}
@@ -0,0 +1,330 @@
+package table
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/tinybit/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+)
+
+func testGroupByTable() Writer {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"Region", "City", "Sales", "Units"})
+	tw.AppendRow(Row{"North", "Winterfell", 3000, 5})
+	tw.AppendRow(Row{"South", "Sunspear", 1500.5, 2})
+	tw.AppendRow(Row{"North", "The Wall", 2000, 3})
+	tw.AppendRow(Row{"Crownlands", "King's Landing", 5000, 10})
+	tw.AppendFooter(Row{"", "Total", 11500.5, 20})
+	return tw
+}
+
+var testGroupBySalesUnits = []GroupBy{{
+	Name: "Region",
+	Aggregates: []Aggregate{
+		{Name: "Sales", Func: AggregateSum},
+		{Number: 4, Func: AggregateAvg},
+	},
+}}
+
+func TestTable_GroupBy(t *testing.T) {
+	t.Run("with subtotals", func(t *testing.T) {
+		tw := testGroupByTable()
+		tw.GroupBy(testGroupBySalesUnits)
+
+		compareOutput(t, tw.Render(), `
++------------+----------------+---------+-------+
+| REGION     | CITY           |   SALES | UNITS |
++------------+----------------+---------+-------+
+| North (2)                                     |
+| North      | Winterfell     |    3000 |     5 |
+| North      | The Wall       |    2000 |     3 |
+| Subtotal   |                |    5000 |     4 |
++------------+----------------+---------+-------+
+| South (1)                                     |
+| South      | Sunspear       |  1500.5 |     2 |
+| Subtotal   |                |  1500.5 |     2 |
++------------+----------------+---------+-------+
+| Crownlands (1)                                |
+| Crownlands | King's Landing |    5000 |    10 |
+| Subtotal   |                |    5000 |    10 |
++------------+----------------+---------+-------+
+|            | TOTAL          | 11500.5 |    20 |
++------------+----------------+---------+-------+`)
+	})
+
+	t.Run("without subtotals", func(t *testing.T) {
+		tw := testGroupByTable()
+		tw.GroupBy([]GroupBy{{Number: 1}})
+		tw.SetColumnConfigs([]ColumnConfig{{Name: "Region", Hidden: true}})
+
+		compareOutput(t, tw.Render(), `
++----------------+---------+-------+
+| CITY           |   SALES | UNITS |
++----------------+---------+-------+
+| North (2)                        |
+| Winterfell     |    3000 |     5 |
+| The Wall       |    2000 |     3 |
++----------------+---------+-------+
+| South (1)                        |
+| Sunspear       |  1500.5 |     2 |
++----------------+---------+-------+
+| Crownlands (1)                   |
+| King's Landing |    5000 |    10 |
++----------------+---------+-------+
+| TOTAL          | 11500.5 |    20 |
++----------------+---------+-------+`)
+	})
+
+	t.Run("auto index", func(t *testing.T) {
+		tw := testGroupByTable()
+		tw.GroupBy(testGroupBySalesUnits)
+		tw.SetAutoIndex(true)
+		tw.SetStyle(StyleLight)
+
+		compareOutput(t, tw.Render(), `
+┌────┬────────────┬────────────────┬─────────┬───────┐
+│    │ REGION     │ CITY           │   SALES │ UNITS │
+├────┼────────────┴────────────────┴─────────┴───────┤
+│  1 │ North (2)                                     │
+│  2 │ North      │ Winterfell     │    3000 │     5 │
+│  3 │ North      │ The Wall       │    2000 │     3 │
+│  4 │ Subtotal   │                │    5000 │     4 │
+├────┼────────────┴────────────────┴─────────┴───────┤
+│  5 │ South (1)                                     │
+│  6 │ South      │ Sunspear       │  1500.5 │     2 │
+│  7 │ Subtotal   │                │  1500.5 │     2 │
+├────┼────────────┴────────────────┴─────────┴───────┤
+│  8 │ Crownlands (1)                                │
+│  9 │ Crownlands │ King's Landing │    5000 │    10 │
+│ 10 │ Subtotal   │                │    5000 │    10 │
+├────┼────────────┼────────────────┼─────────┼───────┤
+│    │            │ TOTAL          │ 11500.5 │    20 │
+└────┴────────────┴────────────────┴─────────┴───────┘`)
+	})
+
+	t.Run("collapsed and sorted", func(t *testing.T) {
+		tw := testGroupByTable()
+		tw.GroupBy([]GroupBy{{
+			Name:     "Region",
+			Collapse: true,
+			Aggregates: []Aggregate{
+				{Name: "City", Func: AggregateCount},
+				{Name: "Sales", Func: AggregateSum},
+			},
+			HeaderFormat: func(value string, numRows int) string {
+				return fmt.Sprintf("Region: %s", value)
+			},
+			SubtotalLabel: "Cities",
+		}})
+		tw.SortBy([]SortBy{{Name: "Sales", Mode: DscNumeric}})
+
+		compareOutput(t, tw.Render(), `
++--------+-------+---------+-------+
+| REGION | CITY  |   SALES | UNITS |
++--------+-------+---------+-------+
+| Region: Crownlands               |
+| Cities | 1     |    5000 |       |
++--------+-------+---------+-------+
+| Region: North                    |
+| Cities | 2     |    5000 |       |
++--------+-------+---------+-------+
+| Region: South                    |
+| Cities | 1     |  1500.5 |       |
++--------+-------+---------+-------+
+|        | TOTAL | 11500.5 |    20 |
++--------+-------+---------+-------+`)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		tw := testGroupByTable()
+		tw.AppendRow(Row{"North", "Winterfell", 1000, 1})
+		tw.GroupBy([]GroupBy{
+			{Name: "Region", HeaderHidden: true, Aggregates: []Aggregate{{Name: "Units", Func: AggregateSum}}, SubtotalLabel: "Total"},
+			{Name: "City", Aggregates: []Aggregate{{Name: "Units", Func: AggregateMax}}},
+		})
+
+		compareOutput(t, tw.Render(), `
++------------+----------------+---------+-------+
+| REGION     | CITY           |   SALES | UNITS |
++------------+----------------+---------+-------+
+| Winterfell (2)                                |
+| North      | Winterfell     |    3000 |     5 |
+| North      | Winterfell     |    1000 |     1 |
+|            | Subtotal       |         |     5 |
+| The Wall (1)                                  |
+| North      | The Wall       |    2000 |     3 |
+|            | Subtotal       |         |     3 |
+| Total      |                |         |     9 |
++------------+----------------+---------+-------+
+| Sunspear (1)                                  |
+| South      | Sunspear       |  1500.5 |     2 |
+|            | Subtotal       |         |     2 |
+| Total      |                |         |     2 |
++------------+----------------+---------+-------+
+| King's Landing (1)                            |
+| Crownlands | King's Landing |    5000 |    10 |
+|            | Subtotal       |         |    10 |
+| Total      |                |         |    10 |
++------------+----------------+---------+-------+
+|            | TOTAL          | 11500.5 |    20 |
++------------+----------------+---------+-------+`)
+	})
+
+	t.Run("colors", func(t *testing.T) {
+		tw := testGroupByTable()
+		tw.GroupBy([]GroupBy{{
+			Name:           "Region",
+			Aggregates:     []Aggregate{{Name: "Sales", Func: AggregateSum}},
+			HeaderColors:   text.Colors{text.Bold},
+			SubtotalColors: text.Colors{text.Italic},
+		}})
+		tw.FilterBy([]FilterBy{{Name: "Region", Value: "South"}})
+		tw.SetStyle(StyleLight)
+
+		compareOutputColored(t, tw.Render(), ""+
+			"┌──────────┬──────────┬─────────┬───────┐\n"+
+			"│ REGION   │ CITY     │   SALES │ UNITS │\n"+
+			"├──────────┴──────────┴─────────┴───────┤\n"+
+			"│\x1b[1m South (1)                             \x1b[0m│\n"+
+			"│ South    │ Sunspear │  1500.5 │     2 │\n"+
+			"│\x1b[3m Subtotal \x1b[0m│\x1b[3m          \x1b[0m│\x1b[3m  1500.5 \x1b[0m│\x1b[3m       \x1b[0m│\n"+
+			"├──────────┼──────────┼─────────┼───────┤\n"+
+			"│          │ TOTAL    │ 11500.5 │    20 │\n"+
+			"└──────────┴──────────┴─────────┴───────┘")
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		tw := testGroupByTable()
+		tw.GroupBy([]GroupBy{{Name: "Foo"}})
+		out := tw.Render()
+		tw.GroupBy(nil)
+		assert.Equal(t, tw.Render(), out)
+	})
+}
+
+func TestTable_GroupBy_RenderFormats(t *testing.T) {
+	tw := testGroupByTable()
+	tw.GroupBy(testGroupBySalesUnits)
+
+	t.Run("HTML", func(t *testing.T) {
+		compareOutput(t, tw.RenderHTML(), `
+<table class="go-pretty-table">
+  <thead>
+  <tr>
+    <th>Region</th>
+    <th>City</th>
+    <th align="right">Sales</th>
+    <th align="right">Units</th>
+  </tr>
+  </thead>
+  <tbody>
+  <tr class="group-header">
+    <td colspan=4>North (2)</td>
+  </tr>
+  <tr>
+    <td>North</td>
+    <td>Winterfell</td>
+    <td align="right">3000</td>
+    <td align="right">5</td>
+  </tr>
+  <tr>
+    <td>North</td>
+    <td>The Wall</td>
+    <td align="right">2000</td>
+    <td align="right">3</td>
+  </tr>
+  <tr class="group-subtotal">
+    <td>Subtotal</td>
+    <td>&nbsp;</td>
+    <td align="right">5000</td>
+    <td align="right">4</td>
+  </tr>
+  </tbody>
+  <tbody>
+  <tr class="group-header">
+    <td colspan=4>South (1)</td>
+  </tr>
+  <tr>
+    <td>South</td>
+    <td>Sunspear</td>
+    <td align="right">1500.5</td>
+    <td align="right">2</td>
+  </tr>
+  <tr class="group-subtotal">
+    <td>Subtotal</td>
+    <td>&nbsp;</td>
+    <td align="right">1500.5</td>
+    <td align="right">2</td>
+  </tr>
+  </tbody>
+  <tbody>
+  <tr class="group-header">
+    <td colspan=4>Crownlands (1)</td>
+  </tr>
+  <tr>
+    <td>Crownlands</td>
+    <td>King&#39;s Landing</td>
+    <td align="right">5000</td>
+    <td align="right">10</td>
+  </tr>
+  <tr class="group-subtotal">
+    <td>Subtotal</td>
+    <td>&nbsp;</td>
+    <td align="right">5000</td>
+    <td align="right">10</td>
+  </tr>
+  </tbody>
+  <tfoot>
+  <tr>
+    <td>&nbsp;</td>
+    <td>Total</td>
+    <td align="right">11500.5</td>
+    <td align="right">20</td>
+  </tr>
+  </tfoot>
+</table>`)
+	})
+
+	t.Run("Markdown", func(t *testing.T) {
+		compareOutput(t, tw.RenderMarkdown(), `
+| Region | City | Sales | Units |
+| --- | --- | ---:| ---:|
+| **North (2)** |  |  |  |
+| North | Winterfell | 3000 | 5 |
+| North | The Wall | 2000 | 3 |
+| **Subtotal** |  | **5000** | **4** |
+| **South (1)** |  |  |  |
+| South | Sunspear | 1500.5 | 2 |
+| **Subtotal** |  | **1500.5** | **2** |
+| **Crownlands (1)** |  |  |  |
+| Crownlands | King's Landing | 5000 | 10 |
+| **Subtotal** |  | **5000** | **10** |
+|  | Total | 11500.5 | 20 |`)
+	})
+
+	t.Run("NDJSON", func(t *testing.T) {
+		compareOutput(t, tw.RenderNDJSON(), `
+{"Region":"North","City":"Winterfell","Sales":3000,"Units":5}
+{"Region":"North","City":"The Wall","Sales":2000,"Units":3}
+{"Region":"South","City":"Sunspear","Sales":1500.5,"Units":2}
+{"Region":"Crownlands","City":"King's Landing","Sales":5000,"Units":10}`)
+	})
+}
+
+func TestTable_GroupBy_AppendAfterRender(t *testing.T) {
+	tw := testGroupByTable()
+	tw.GroupBy(testGroupBySalesUnits)
+	tw.Render()
+	assert.Equal(t, 4, tw.Length())
+
+	// the group header/subtotal rows should not affect the rows appended later
+	rowConfig := RowConfig{AutoMerge: true}
+	tw.AppendRow(Row{"South", "Oldtown", 1000, 1}, rowConfig)
+	tw.AppendSeparator()
+	assert.Equal(t, 5, tw.Length())
+	assert.Equal(t, map[int]RowConfig{4: rowConfig}, tw.(*Table).rowsConfigMap)
+	assert.Equal(t, map[int]bool{4: true}, tw.(*Table).separators)
+	tw.Render()
+	assert.Equal(t, 5, tw.Length())
+}
@@ -0,0 +1,154 @@
+package table
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CSVImportOptions defines the options to control how ImportCSV reads and
+// interprets the input.
+type CSVImportOptions struct {
+	Comment     rune     // lines beginning with this character are skipped (0 to disable)
+	Delimiter   rune     // field delimiter (defaults to ',' if 0)
+	HasHeader   bool     // is the first record a Header row?
+	InferTypes  bool     // convert cells to int/float64/bool/time.Time where possible?
+	TimeLayouts []string // layouts to try when inferring time values (defaults to CSVTimeLayoutsDefault)
+	TrimSpace   bool     // trim leading and trailing white-space in every cell?
+}
+
+var (
+	// CSVImportOptionsDefault defines sensible defaults for importing CSV.
+	CSVImportOptionsDefault = CSVImportOptions{
+		Comment:    0,
+		Delimiter:  ',',
+		HasHeader:  true,
+		InferTypes: true,
+		TrimSpace:  true,
+	}
+
+	// TSVImportOptionsDefault defines sensible defaults for importing TSV.
+	TSVImportOptionsDefault = CSVImportOptions{
+		Comment:    0,
+		Delimiter:  '\t',
+		HasHeader:  true,
+		InferTypes: true,
+		TrimSpace:  true,
+	}
+
+	// CSVTimeLayoutsDefault defines the layouts tried when inferring time
+	// values if CSVImportOptions.TimeLayouts is empty.
+	CSVTimeLayoutsDefault = []string{
+		time.RFC3339Nano,
+		"2006-01-02 15:04:05",
+		"2006-01-02",
+	}
+)
+
+// ImportCSV reads all the records from the given CSV (or TSV, or any other
+// delimiter-separated values) input and appends them as rows, with the first
+// record being appended as a Header row if opts.HasHeader is set. Records with
+// differing numbers of fields are allowed.
+//
+// If opts.InferTypes is set, every (non-header) cell is converted to the first
+// of the following types it can be parsed as:
+//   - int (or float64 if it does not fit in an int)
+//   - float64
+//   - bool ("true" or "false" in any case)
+//   - time.Time (using the layouts in opts.TimeLayouts)
+//
+// so that the cells are aligned, sorted, filtered and transformed like typed
+// values appended using AppendRow. Numbers with leading zeroes (like "007") and
+// empty cells are left as strings.
+//
+// Nothing is appended if the input cannot be parsed, and the error is returned.
+func (t *Table) ImportCSV(r io.Reader, opts CSVImportOptions) error {
+	reader := csv.NewReader(r)
+	reader.Comment = opts.Comment
+	if opts.Delimiter != 0 {
+		reader.Comma = opts.Delimiter
+	}
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return err
+	}
+
+	for recordIdx, record := range records {
+		row := make(Row, len(record))
+		for colIdx, colStr := range record {
+			if opts.TrimSpace {
+				colStr = strings.TrimSpace(colStr)
+			}
+			row[colIdx] = colStr
+			if opts.InferTypes && (recordIdx > 0 || !opts.HasHeader) {
+				row[colIdx] = csvInferType(colStr, opts.TimeLayouts)
+			}
+		}
+
+		if recordIdx == 0 && opts.HasHeader {
+			t.AppendHeader(row)
+		} else {
+			t.AppendRow(row)
+		}
+	}
+	return nil
+}
+
+// csvInferType returns the given string converted to the first of int, float64,
+// bool or time.Time it can be parsed as, or the string itself otherwise.
+func csvInferType(str string, timeLayouts []string) interface{} {
+	if str == "" {
+		return str
+	}
+	if csvIsNumber(str) {
+		if i, err := strconv.Atoi(str); err == nil {
+			return i
+		}
+		if f, err := strconv.ParseFloat(str, 64); err == nil {
+			return f
+		}
+	}
+	switch strings.ToLower(str) {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+	if len(timeLayouts) == 0 {
+		timeLayouts = CSVTimeLayoutsDefault
+	}
+	for _, layout := range timeLayouts {
+		if tm, err := time.Parse(layout, str); err == nil {
+			return tm
+		}
+	}
+	return str
+}
+
+// csvIsNumber returns true if the string looks like a decimal number without
+// superfluous leading zeroes; this avoids strconv accepting things like "Inf",
+// "NaN", hex values, or underscores, and identifiers like ZIP codes losing
+// their leading zeroes.
+func csvIsNumber(str string) bool {
+	digits := strings.TrimLeft(str, "+-")
+	if len(str)-len(digits) > 1 || digits == "" {
+		return false
+	}
+	if len(digits) > 1 && digits[0] == '0' && digits[1] != '.' {
+		return false
+	}
+	hasDigit := false
+	for _, c := range digits {
+		switch {
+		case c >= '0' && c <= '9':
+			hasDigit = true
+		case c == '.' || c == 'e' || c == 'E' || c == '+' || c == '-':
+		default:
+			return false
+		}
+	}
+	return hasDigit
+}
@@ -0,0 +1,89 @@
+package table
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTable_ImportCSV(t *testing.T) {
+	csvIn := `; Game of Thrones
+#,First Name,Last Name,Salary,Notes
+1,Arya,Stark,3000,
+20, Jon ,Snow,2000,"You know nothing, Jon Snow!"
+300,Tyrion,Lannister,5000.50,true
+`
+	opts := CSVImportOptionsDefault
+	opts.Comment = ';'
+
+	tw := NewWriter()
+	assert.Nil(t, tw.ImportCSV(strings.NewReader(csvIn), opts))
+	tw.FilterBy([]FilterBy{{Name: "Salary", Operator: GreaterThan, Value: 2500}})
+	tw.SortBy([]SortBy{{Name: "Salary", Mode: DscNumeric}})
+	compareOutput(t, tw.Render(), `
++-----+------------+-----------+--------+-------+
+|   # | FIRST NAME | LAST NAME | SALARY | NOTES |
++-----+------------+-----------+--------+-------+
+| 300 | Tyrion     | Lannister | 5000.5 | true  |
+|   1 | Arya       | Stark     |   3000 |       |
++-----+------------+-----------+--------+-------+`)
+	compareOutput(t, tw.RenderJSON(), `
+[
+  {"#":300,"First Name":"Tyrion","Last Name":"Lannister","Salary":5000.5,"Notes":true},
+  {"#":1,"First Name":"Arya","Last Name":"Stark","Salary":3000,"Notes":""}
+]`)
+}
+
+func TestTable_ImportCSV_Error(t *testing.T) {
+	tw := NewWriter()
+	err := tw.ImportCSV(strings.NewReader("a,\"b\nc,d"), CSVImportOptionsDefault)
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, tw.Length())
+}
+
+func TestTable_ImportCSV_NoHeaderNoInference(t *testing.T) {
+	tw := NewWriter()
+	err := tw.ImportCSV(strings.NewReader("1\tArya \n20\tJon"), CSVImportOptions{Delimiter: '\t'})
+	assert.Nil(t, err)
+	compareOutput(t, tw.Render(), `
++----+-------+
+| 1  | Arya  |
+| 20 | Jon   |
++----+-------+`)
+}
+
+func TestTable_ImportCSV_TSVRoundTrip(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(testHeader)
+	tw.AppendRows(testRows)
+	tw.AppendRow(testRowMultiLine)
+	tsv := tw.RenderTSV()
+
+	tw2 := NewWriter()
+	assert.Nil(t, tw2.ImportCSV(strings.NewReader(tsv), TSVImportOptionsDefault))
+	assert.Equal(t, tsv, tw2.RenderTSV())
+}
+
+func TestCSVInferType(t *testing.T) {
+	tm := time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC)
+
+	assert.Equal(t, "", csvInferType("", nil))
+	assert.Equal(t, 42, csvInferType("42", nil))
+	assert.Equal(t, -42, csvInferType("-42", nil))
+	assert.Equal(t, 0, csvInferType("0", nil))
+	assert.Equal(t, 3.14, csvInferType("3.14", nil))
+	assert.Equal(t, 0.5, csvInferType("0.5", nil))
+	assert.Equal(t, 1e3, csvInferType("1e3", nil))
+	assert.Equal(t, 1e20, csvInferType("100000000000000000000", nil))
+	assert.Equal(t, true, csvInferType("TRUE", nil))
+	assert.Equal(t, false, csvInferType("false", nil))
+	assert.Equal(t, tm, csvInferType("2024-03-15T10:30:00Z", nil))
+	assert.Equal(t, tm, csvInferType("2024-03-15 10:30:00", nil))
+	assert.Equal(t, tm, csvInferType("15/03/2024 10:30", []string{"02/01/2006 15:04"}))
+	assert.Equal(t, "2024-03-15", csvInferType("2024-03-15", []string{"02/01/2006"}))
+	for _, str := range []string{"007", "Inf", "NaN", "0x1F", "1_000", "+-1", "e", ".", "T", "1.2.3.4"} {
+		assert.Equal(t, str, csvInferType(str, nil), str)
+	}
+}
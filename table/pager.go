@@ -1,13 +1,23 @@
 package table
 
 import (
+	"fmt"
 	"io"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/term"
 )
 
 // Pager lets you interact with the table rendering in a paged manner.
 type Pager interface {
+	// First moves to the first page and returns the same.
+	First() string
 	// GoTo moves to the given 1-indexed page number.
 	GoTo(pageNum int) string
+	// Last moves to the last page and returns the same.
+	Last() string
 	// Location returns the current page number in 1-indexed form.
 	Location() int
 	// Next moves to the next available page and returns the same.
@@ -19,6 +29,8 @@ type Pager interface {
 	// SetOutputMirror sets up the writer to which Render() will write the
 	// output other than returning.
 	SetOutputMirror(mirror io.Writer)
+	// TotalPages returns the number of pages.
+	TotalPages() int
 }
 
 type pager struct {
@@ -26,9 +38,30 @@ type pager struct {
 	pages        []string
 	outputMirror io.Writer
 	size         int
+
+	footerFormat       string
+	height             int
+	heightFromTerminal bool
+	noRepeatHeader     bool
+	pageBreaks         []pageBreak
+	rendering          bool // true while rendering the pages
+	repeatTitle        bool
+	table              *Table
+	tableVersion       int // the version of the Table when the pages were rendered
+}
+
+// pageBreak records the rows around a page break to describe the pages.
+type pageBreak struct {
+	lastRow int // the last row (1-indexed) on the page before the break
+	nextRow int // the first row (1-indexed) on the page after the break
+}
+
+func (p *pager) First() string {
+	return p.GoTo(1)
 }
 
 func (p *pager) GoTo(pageNum int) string {
+	p.renderPages()
 	if pageNum < 1 {
 		pageNum = 1
 	}
@@ -39,11 +72,16 @@ func (p *pager) GoTo(pageNum int) string {
 	return p.pages[p.index]
 }
 
+func (p *pager) Last() string {
+	return p.GoTo(p.TotalPages())
+}
+
 func (p *pager) Location() int {
 	return p.index + 1
 }
 
 func (p *pager) Next() string {
+	p.renderPages()
 	if p.index < len(p.pages)-1 {
 		p.index++
 	}
@@ -51,6 +89,7 @@ func (p *pager) Next() string {
 }
 
 func (p *pager) Prev() string {
+	p.renderPages()
 	if p.index > 0 {
 		p.index--
 	}
@@ -58,6 +97,7 @@ func (p *pager) Prev() string {
 }
 
 func (p *pager) Render() string {
+	p.renderPages()
 	pageToWrite := p.pages[p.index]
 	if p.outputMirror != nil {
 		_, _ = p.outputMirror.Write([]byte(pageToWrite))
@@ -68,3 +108,149 @@ func (p *pager) Render() string {
 func (p *pager) SetOutputMirror(mirror io.Writer) {
 	p.outputMirror = mirror
 }
+
+func (p *pager) TotalPages() int {
+	p.renderPages()
+	return len(p.pages)
+}
+
+// getHeight returns the maximum number of lines a page can have, if asked to
+// fit the pages within a height.
+func (p *pager) getHeight() int {
+	if p.heightFromTerminal {
+		if _, height, err := term.GetSize(int(os.Stdout.Fd())); err == nil && height > 0 {
+			return height
+		}
+	}
+	return p.height
+}
+
+// repeatsHeader returns true if the header rows are to be rendered at the top
+// of every page; always the case outside of the Pager.
+func (p *pager) repeatsHeader() bool {
+	return !p.rendering || !p.noRepeatHeader
+}
+
+// repeatsTitle returns true if the title is to be rendered at the top of every
+// page; never the case outside of the Pager.
+func (p *pager) repeatsTitle() bool {
+	return p.rendering && p.repeatTitle
+}
+
+// getPageRows returns the first and the last row (1-indexed) on the page
+// separated by the given page break number.
+func (p *pager) getPageRows(pageBreakIdx int, numRows int) (int, int) {
+	if numRows == 0 {
+		return 0, 0
+	}
+	first, last := 1, numRows
+	if pageBreakIdx > 0 {
+		first = p.pageBreaks[pageBreakIdx-1].nextRow
+	}
+	if pageBreakIdx < len(p.pageBreaks) {
+		last = p.pageBreaks[pageBreakIdx].lastRow
+	}
+	return first, last
+}
+
+// renderPages renders the Table into pages if not done already since the
+// options were last changed using Table.Pager(), or if the Table has changed
+// since the pages were rendered.
+func (p *pager) renderPages() {
+	if p.table == nil || (p.pages != nil && p.tableVersion == p.table.version) {
+		return
+	}
+
+	if height := p.getHeight(); height > 0 {
+		origSize := p.size
+		defer func() {
+			p.size = origSize
+		}()
+		p.size = p.table.getPageSizeForHeight(height)
+	}
+	p.pages = p.table.renderPages()
+
+	if p.footerFormat != "" {
+		// the pages might have been split into panes, and each pane would have
+		// the same page breaks
+		numRows := len(p.table.rows)
+		numPagesPerBreak := len(p.pages) / (len(p.pageBreaks) + 1)
+		for pageIdx := range p.pages {
+			first, last := p.getPageRows(pageIdx/numPagesPerBreak, numRows)
+			footer := strings.NewReplacer(
+				"{page}", fmt.Sprint(pageIdx+1),
+				"{pages}", fmt.Sprint(len(p.pages)),
+				"{first}", fmt.Sprint(first),
+				"{last}", fmt.Sprint(last),
+				"{rows}", fmt.Sprint(numRows),
+			).Replace(p.footerFormat)
+			p.pages[pageIdx] += "\n" + footer
+		}
+	}
+	if p.index >= len(p.pages) {
+		p.index = len(p.pages) - 1
+	}
+}
+
+// getPageSizeForHeight returns the number of row lines that can be rendered on
+// each page without the page going over the given number of lines.
+func (t *Table) getPageSizeForHeight(height int) int {
+	// render with a single row line per page to find how many lines are taken
+	// up by everything else: title, borders, header, footer, etc.
+	t.pager.size = 1
+	pages := t.renderPages()
+	numLinesMax := 0
+	for idx := 0; idx < len(pages) && idx < 2; idx++ {
+		if numLines := strings.Count(pages[idx], "\n") + 1; numLines > numLinesMax {
+			numLinesMax = numLines
+		}
+	}
+	numLinesOther := numLinesMax - 1
+	if t.pager.footerFormat != "" {
+		numLinesOther++
+	}
+
+	size := height - numLinesOther
+	if t.style.Options.SeparateRows {
+		size = (size + 1) / 2
+	}
+	if size < 1 {
+		size = 1
+	}
+	return size
+}
+
+// recordPageBreak records the rows on either side of a page break being
+// rendered after the given row (or a line of it).
+func (t *Table) recordPageBreak(rowNumber int, isLastLineOfRow bool) {
+	nextRow := rowNumber
+	if isLastLineOfRow {
+		nextRow++
+	}
+	t.pager.pageBreaks = append(t.pager.pageBreaks, pageBreak{lastRow: rowNumber, nextRow: nextRow})
+}
+
+// renderPages renders the Table and splits the output into pages.
+func (t *Table) renderPages() []string {
+	// use a temporary page separator for splitting up the pages
+	tempPageSep := fmt.Sprintf("%p // page separator // %d", t.rows, time.Now().UnixNano())
+
+	// backup
+	origOutputMirror, origPageSep := t.outputMirror, t.getStyle().Box.PageSeparator
+	// restore on exit
+	defer func() {
+		t.outputMirror = origOutputMirror
+		t.getStyle().Box.PageSeparator = origPageSep
+	}()
+	// override
+	t.outputMirror = nil
+	t.getStyle().Box.PageSeparator = tempPageSep
+	t.pager.rendering = true
+	defer func() {
+		t.pager.rendering = false
+	}()
+	// render
+	pages := splitPages(t.Render(), tempPageSep)
+	t.pager.tableVersion = t.version
+	return pages
+}
@@ -3,6 +3,57 @@ package table
 // PagerOption helps control Paging.
 type PagerOption func(t *Table)
 
+// PageFooter sets the template for a line to render below each page. The
+// following placeholders in it get replaced with the details of the page:
+//   - {page}: the page number
+//   - {pages}: the total number of pages
+//   - {first}: the number of the first row on the page
+//   - {last}: the number of the last row on the page
+//   - {rows}: the total number of rows
+//
+// Example: "Page {page} of {pages} (rows {first}-{last})"
+func PageFooter(format string) PagerOption {
+	return func(t *Table) {
+		t.pager.footerFormat = format
+	}
+}
+
+// PageHeight sets the size of each page such that no page is taller than the
+// given number of lines, including the title, the header and footer rows, the
+// borders and the page footer.
+func PageHeight(numLines int) PagerOption {
+	return func(t *Table) {
+		t.pager.height = numLines
+	}
+}
+
+// PageHeightFromTerminal sets the size of each page such that every page fits
+// within the height of the terminal (os.Stdout). The value set using
+// PageHeight (or PageSize) is used if the height cannot be determined.
+func PageHeightFromTerminal() PagerOption {
+	return func(t *Table) {
+		t.pager.heightFromTerminal = true
+	}
+}
+
+// PageRepeatHeader sets whether the header rows are rendered again at the top
+// of each page (the default) or only on the first page of the Pager; Render()
+// always repeats them.
+func PageRepeatHeader(repeat bool) PagerOption {
+	return func(t *Table) {
+		t.pager.noRepeatHeader = !repeat
+	}
+}
+
+// PageRepeatTitle sets whether the title is rendered again at the top of each
+// page of the Pager or only on the first page (the default); Render() always
+// renders it only once.
+func PageRepeatTitle(repeat bool) PagerOption {
+	return func(t *Table) {
+		t.pager.repeatTitle = repeat
+	}
+}
+
 // PageSize sets the size of each page rendered.
 func PageSize(pageSize int) PagerOption {
 	return func(t *Table) {
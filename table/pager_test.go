@@ -1,6 +1,7 @@
 package table
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 
@@ -106,3 +107,173 @@ func TestPager(t *testing.T) {
 	p.Render()
 	compareOutput(t, expectedOutputP4, sb.String())
 }
+
+func TestPager_Options(t *testing.T) {
+	newTable := func() Writer {
+		tw := NewWriter()
+		tw.AppendHeader(Row{"#", "Name"})
+		tw.AppendRows([]Row{
+			{1, "Arya"}, {2, "Jon"}, {3, "Tyrion"}, {4, "Sansa"}, {5, "Bran"},
+		})
+		tw.SetTitle("Starks")
+		return tw
+	}
+
+	t.Run("first last total", func(t *testing.T) {
+		tw := newTable()
+		p := tw.Pager(PageSize(2))
+		assert.Equal(t, 3, p.TotalPages())
+		compareOutput(t, p.Last(), `
++---+--------+
+| # | NAME   |
++---+--------+
+| 5 | Bran   |
++---+--------+`)
+		assert.Equal(t, 3, p.Location())
+		compareOutput(t, p.First(), `
++------------+
+| Starks     |
++---+--------+
+| # | NAME   |
++---+--------+
+| 1 | Arya   |
+| 2 | Jon    |
++---+--------+`)
+		assert.Equal(t, 1, p.Location())
+
+		// the pager renders the table only when a page is asked for
+		p = tw.Pager(PageSize(3))
+		tw.AppendRow(Row{6, "Rickon"})
+		assert.Equal(t, 2, p.TotalPages())
+		compareOutput(t, p.Last(), `
++---+--------+
+| # | NAME   |
++---+--------+
+| 4 | Sansa  |
+| 5 | Bran   |
+| 6 | Rickon |
++---+--------+`)
+	})
+
+	t.Run("repeat header and title", func(t *testing.T) {
+		tw := newTable()
+		p := tw.Pager(PageSize(3), PageRepeatHeader(false), PageRepeatTitle(true))
+		compareOutput(t, p.Next(), `
++------------+
+| Starks     |
++---+--------+
+| 4 | Sansa  |
+| 5 | Bran   |
++---+--------+`)
+
+		p = tw.Pager(PageRepeatHeader(true))
+		compareOutput(t, p.Render(), `
++------------+
+| Starks     |
++---+--------+
+| # | NAME   |
++---+--------+
+| 4 | Sansa  |
+| 5 | Bran   |
++---+--------+`)
+	})
+
+	t.Run("cached pages", func(t *testing.T) {
+		numRenders := 0
+		tw := newTable()
+		tw.SetColumnConfigs([]ColumnConfig{{Name: "#", Transformer: func(val interface{}) string {
+			if val == 1 {
+				numRenders++
+			}
+			return fmt.Sprint(val)
+		}}})
+
+		p := tw.Pager(PageSize(2))
+		assert.Equal(t, 3, p.TotalPages())
+		assert.Equal(t, 1, numRenders)
+		// neither asking for the Pager again nor rendering the table as usual
+		// renders the pages again
+		p = tw.Pager()
+		assert.Equal(t, 3, p.TotalPages())
+		tw.Render()
+		p.Next()
+		assert.Equal(t, 2, numRenders)
+		assert.Equal(t, 2, p.Location())
+
+		tw.SetTitle("Winterfell")
+		assert.Contains(t, p.First(), "Winterfell")
+		assert.Equal(t, 3, numRenders)
+	})
+
+	t.Run("options do not leak into Render", func(t *testing.T) {
+		tw := newTable()
+		p := tw.Pager(PageSize(3), PageRepeatHeader(false), PageRepeatTitle(true))
+		assert.Equal(t, 2, p.TotalPages())
+
+		compareOutput(t, tw.Render(), `
++------------+
+| Starks     |
++---+--------+
+| # | NAME   |
++---+--------+
+| 1 | Arya   |
+| 2 | Jon    |
+| 3 | Tyrion |
++---+--------+
+
++---+--------+
+| # | NAME   |
++---+--------+
+| 4 | Sansa  |
+| 5 | Bran   |
++---+--------+`)
+	})
+
+	t.Run("footer", func(t *testing.T) {
+		tw := newTable()
+		tw.SetColumnConfigs([]ColumnConfig{{Name: "Name", WidthMax: 4}})
+		p := tw.Pager(PageSize(2), PageFooter("Page {page} of {pages} (rows {first}-{last} of {rows})"))
+		compareOutput(t, p.Render(), `
++----------+
+| Starks   |
++---+------+
+| # | NAME |
++---+------+
+| 1 | Arya |
+| 2 | Jon  |
++---+------+
+Page 1 of 4 (rows 1-2 of 5)`)
+		compareOutput(t, p.Next(), `
++---+------+
+| # | NAME |
++---+------+
+| 3 | Tyri |
+|   | on   |
++---+------+
+Page 2 of 4 (rows 3-3 of 5)`)
+		compareOutput(t, p.Last(), `
++---+------+
+| # | NAME |
++---+------+
+| 5 | Bran |
++---+------+
+Page 4 of 4 (rows 5-5 of 5)`)
+	})
+
+	t.Run("height", func(t *testing.T) {
+		tw := newTable()
+		p := tw.Pager(PageHeight(9), PageFooter("Page {page} of {pages}"))
+		assert.Equal(t, 3, p.TotalPages())
+		for pageNum := 1; pageNum <= p.TotalPages(); pageNum++ {
+			assert.LessOrEqual(t, strings.Count(p.GoTo(pageNum), "\n")+1, 9)
+		}
+		compareOutput(t, p.GoTo(2), `
++---+--------+
+| # | NAME   |
++---+--------+
+| 3 | Tyrion |
+| 4 | Sansa  |
++---+--------+
+Page 2 of 3`)
+	})
+}
@@ -0,0 +1,417 @@
+package table
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/tinybit/go-pretty/v6/text"
+)
+
+var (
+	// ErrParseNoTable is returned by Parse when the input does not contain a
+	// Table rendered using the Style.
+	ErrParseNoTable = errors.New("no table found in the input")
+	// ErrParseUnknownStyle is returned by Parse when the Style of the Table
+	// could not be detected.
+	ErrParseUnknownStyle = errors.New("unable to detect the style of the table")
+
+	// parseStyles is the list of Styles DetectStyle looks for.
+	parseStyles = []Style{
+		StyleBold,
+		StyleDouble,
+		StyleLight,
+		StyleRounded,
+		StyleDefault,
+	}
+)
+
+// ParseOption helps control the way Parse recovers the contents of a Table.
+type ParseOption func(p *tableParser)
+
+// ParseSingleLineRows makes Parse treat every line between two separators as a
+// row of its own, instead of treating a line with an empty first column as a
+// continuation of the multi-line row above it. Use this when the first column
+// can be empty in the Table, and none of the rows span multiple lines.
+func ParseSingleLineRows() ParseOption {
+	return func(p *tableParser) {
+		p.singleLineRows = true
+	}
+}
+
+// ParsedTable contains the contents of a Table recovered by Parse. All the
+// cells are strings, with multi-line cells joined using "\n".
+type ParsedTable struct {
+	Caption string
+	Footer  []Row
+	Header  []Row
+	Rows    []Row
+	Style   Style
+	Title   string
+}
+
+// DetectStyle returns the predefined Style (with borders) that was used to
+// render the Table in the given string.
+func DetectStyle(s string) (Style, bool) {
+	for _, line := range strings.Split(text.StripEscape(s), "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		for _, style := range parseStyles {
+			if strings.HasPrefix(line, style.Box.TopLeft+style.Box.MiddleHorizontal) {
+				return style, true
+			}
+		}
+		break
+	}
+	return Style{}, false
+}
+
+// Parse recovers the contents of a Table rendered using Render() with the
+// given Style. A zero-value Style makes Parse detect which of the predefined
+// Styles was used (refer to DetectStyle). Example:
+//
+//	pt, err := table.Parse(out, table.Style{})
+//	if err == nil {
+//		fmt.Println(pt.Header[0][1]) // FIRST NAME
+//		fmt.Println(pt.Rows[0][1])   // Arya
+//	}
+//
+// The columns are identified using the junctions in the horizontal separators,
+// and so the Table needs to have been rendered with borders and column
+// separators. The sections are identified as follows:
+//   - a box above the columns (ex.: "+-----+") contains the Title
+//   - the first group of rows is the Header if followed by other rows, and if
+//     the text matches Style.Format.Header (ex.: upper-case)
+//   - the last group of rows is the Footer if preceded by other (non-Header)
+//     rows, and if the text matches Style.Format.Footer
+//   - any text below the Table is the Caption
+//
+// Cells merged horizontally (RowConfig.AutoMerge) are returned with the same
+// value in every column they span. If the Style has Options.SeparateRows set,
+// cells merged vertically (ColumnConfig.AutoMerge) are returned with the value
+// repeated in every row, and every line between two separators is a part of
+// the same row (which also means that only the first Header row and the last
+// Footer row can be told apart from the rest). Otherwise, a line with an empty
+// first column is considered to be a continuation of the multi-line row above
+// it, unless the ParseSingleLineRows option is used.
+//
+// Text that gets transformed while rendering (ex.: upper-cased Headers and
+// Footers, or using a Transformer) is returned as rendered.
+func Parse(s string, style Style, opts ...ParseOption) (*ParsedTable, error) {
+	if style.Box == (BoxStyle{}) {
+		detectedStyle, ok := DetectStyle(s)
+		if !ok {
+			return nil, ErrParseUnknownStyle
+		}
+		style = detectedStyle
+	}
+
+	p := tableParser{box: style.Box, style: style}
+	for _, opt := range opts {
+		opt(&p)
+	}
+	lines := strings.Split(text.StripEscape(strings.ReplaceAll(s, "\r\n", "\n")), "\n")
+	if err := p.parse(lines); err != nil {
+		return nil, err
+	}
+	return &p.result, nil
+}
+
+// parsedLine is a line of the Table split into runes, with the position (on
+// screen) of each rune to handle wide characters.
+type parsedLine struct {
+	isSeparator bool
+	positions   []int
+	runes       []rune
+}
+
+func newParsedLine(line string) parsedLine {
+	pl := parsedLine{runes: []rune(line)}
+	pl.positions = make([]int, len(pl.runes))
+	pos := 0
+	for idx, r := range pl.runes {
+		pl.positions[idx] = pos
+		pos += text.RuneWidth(r)
+	}
+	return pl
+}
+
+// charAt returns the character at the given position on screen.
+func (pl parsedLine) charAt(pos int) string {
+	idx := sort.SearchInts(pl.positions, pos)
+	if idx < len(pl.positions) && pl.positions[idx] == pos {
+		return string(pl.runes[idx])
+	}
+	return ""
+}
+
+// segment returns the text between the given positions on screen.
+func (pl parsedLine) segment(from int, to int) string {
+	var out strings.Builder
+	for idx, r := range pl.runes {
+		if pos := pl.positions[idx]; pos >= from && pos < to {
+			out.WriteRune(r)
+		}
+	}
+	return out.String()
+}
+
+// parsedBlock is a group of lines between two separators.
+type parsedBlock struct {
+	lines      []parsedLine
+	separators []*parsedLine // separator right above each line (if any)
+}
+
+type tableParser struct {
+	boundaries     []int
+	box            BoxStyle
+	result         ParsedTable
+	singleLineRows bool
+	style          Style
+}
+
+func (p *tableParser) parse(lines []string) error {
+	start, end := -1, -1
+	for idx, line := range lines {
+		if start < 0 && strings.HasPrefix(line, p.box.TopLeft) {
+			start = idx
+		} else if start >= 0 && strings.HasPrefix(line, p.box.BottomLeft) {
+			end = idx
+		}
+	}
+	if start < 0 {
+		return ErrParseNoTable
+	}
+	if end < 0 {
+		end = len(lines) - 1
+	} else if end < len(lines)-1 {
+		p.result.Caption = strings.Trim(strings.Join(lines[end+1:], "\n"), "\n")
+	}
+
+	var tableLines []parsedLine
+	for _, line := range lines[start : end+1] {
+		pl := newParsedLine(strings.TrimRight(line, " "))
+		pl.isSeparator = p.isSeparator(line)
+		tableLines = append(tableLines, pl)
+	}
+	p.boundaries = p.findBoundaries(tableLines)
+	if len(p.boundaries) < 2 {
+		return ErrParseNoTable
+	}
+	tableLines = p.parseTitle(tableLines)
+	p.parseBlocks(p.splitIntoBlocks(tableLines))
+	p.result.Style = p.style
+	return nil
+}
+
+func (p *tableParser) findBoundaries(lines []parsedLine) []int {
+	junctions := map[string]bool{
+		p.box.BottomLeft: true, p.box.BottomRight: true, p.box.BottomSeparator: true,
+		p.box.Left: true, p.box.LeftSeparator: true,
+		p.box.MiddleSeparator: true, p.box.MiddleVertical: true,
+		p.box.Right: true, p.box.RightSeparator: true,
+		p.box.TopLeft: true, p.box.TopRight: true, p.box.TopSeparator: true,
+	}
+
+	positions := make(map[int]bool)
+	for _, line := range lines {
+		if line.isSeparator {
+			for idx, r := range line.runes {
+				if junctions[string(r)] {
+					positions[line.positions[idx]] = true
+				}
+			}
+		}
+	}
+
+	boundaries := make([]int, 0, len(positions))
+	for pos := range positions {
+		boundaries = append(boundaries, pos)
+	}
+	sort.Ints(boundaries)
+	return boundaries
+}
+
+func (p *tableParser) isSeparator(line string) bool {
+	if strings.HasPrefix(line, p.box.TopLeft) || strings.HasPrefix(line, p.box.LeftSeparator) ||
+		strings.HasPrefix(line, p.box.BottomLeft) {
+		return true
+	}
+
+	// a line beginning with the left border is a separator if it has nothing
+	// but box characters; this happens when the first column is merged
+	// vertically
+	box := p.box
+	box.ensureHorizontalInitialized()
+	horizontals := []string{
+		box.MiddleHorizontal,
+		box.Horizontal.TitleTop, box.Horizontal.TitleBottom,
+		box.Horizontal.HeaderTop, box.Horizontal.HeaderMiddle, box.Horizontal.HeaderBottom,
+		box.Horizontal.RowTop, box.Horizontal.RowMiddle, box.Horizontal.RowBottom,
+		box.Horizontal.FooterTop, box.Horizontal.FooterMiddle, box.Horizontal.FooterBottom,
+	}
+	rest := line
+	for _, str := range horizontals {
+		if str != "" {
+			rest = strings.ReplaceAll(rest, str, "")
+		}
+	}
+	if len(rest) == len(line) {
+		return false
+	}
+	for _, str := range []string{box.Left, box.LeftSeparator, box.MiddleSeparator,
+		box.MiddleVertical, box.Right, box.RightSeparator, box.TopSeparator,
+		box.BottomSeparator, " "} {
+		rest = strings.ReplaceAll(rest, str, "")
+	}
+	return rest == ""
+}
+
+// parseTitle extracts the Title if the Table begins with a box without any
+// columns, and returns the rest of the lines.
+func (p *tableParser) parseTitle(lines []parsedLine) []parsedLine {
+	if len(p.boundaries) < 3 || len(lines) < 2 {
+		return lines
+	}
+	for _, pos := range p.boundaries[1 : len(p.boundaries)-1] {
+		if lines[0].charAt(pos) == p.box.TopSeparator {
+			return lines
+		}
+	}
+
+	var titleLines []string
+	idx := 1
+	for ; idx < len(lines) && !lines[idx].isSeparator; idx++ {
+		titleLine := lines[idx].segment(p.boundaries[0]+1, p.boundaries[len(p.boundaries)-1])
+		titleLines = append(titleLines, strings.TrimSpace(titleLine))
+	}
+	p.result.Title = strings.Join(titleLines, "\n")
+	return lines[idx:]
+}
+
+func (p *tableParser) splitIntoBlocks(lines []parsedLine) []parsedBlock {
+	var blocks []parsedBlock
+	var block parsedBlock
+	var separator *parsedLine
+	for idx := range lines {
+		line := &lines[idx]
+		if line.isSeparator {
+			if len(block.lines) > 0 {
+				blocks = append(blocks, block)
+				block = parsedBlock{}
+			}
+			separator = line
+			continue
+		}
+		block.lines = append(block.lines, *line)
+		block.separators = append(block.separators, separator)
+		separator = nil
+	}
+	if len(block.lines) > 0 {
+		blocks = append(blocks, block)
+	}
+	return blocks
+}
+
+func (p *tableParser) parseBlocks(blocks []parsedBlock) {
+	var header, footer []Row
+	if len(blocks) >= 2 {
+		header = p.parseRows(blocks[0], nil)
+		if p.isFormatted(header, p.style.Format.Header) {
+			p.result.Header = header
+			blocks = blocks[1:]
+		}
+	}
+	if len(blocks) >= 2 {
+		footer = p.parseRows(blocks[len(blocks)-1], nil)
+		if p.isFormatted(footer, p.style.Format.Footer) {
+			p.result.Footer = footer
+			blocks = blocks[:len(blocks)-1]
+		}
+	}
+
+	var prevRow Row
+	for _, block := range blocks {
+		rows := p.parseRows(block, prevRow)
+		if len(rows) > 0 {
+			prevRow = rows[len(rows)-1]
+		}
+		p.result.Rows = append(p.result.Rows, rows...)
+	}
+}
+
+// parseRows converts the lines of a block into rows, combining the lines of
+// multi-line rows.
+func (p *tableParser) parseRows(block parsedBlock, prevRow Row) []Row {
+	var rows []Row
+	var rowLines []parsedLine
+	var rowSeparator *parsedLine
+	flush := func() {
+		if len(rowLines) > 0 {
+			row := p.parseRow(rowLines, rowSeparator, prevRow)
+			rows = append(rows, row)
+			prevRow = row
+		}
+	}
+
+	for idx, line := range block.lines {
+		isContinuation := len(rowLines) > 0 && block.separators[idx] == nil
+		if isContinuation && p.singleLineRows {
+			isContinuation = false
+		} else if isContinuation && !p.style.Options.SeparateRows {
+			firstCol := strings.TrimSpace(line.segment(p.boundaries[0]+1, p.boundaries[1]))
+			isContinuation = firstCol == "" && p.parseRow(rowLines[:1], nil, nil)[0] != ""
+		}
+		if !isContinuation {
+			flush()
+			rowLines, rowSeparator = nil, block.separators[idx]
+		}
+		rowLines = append(rowLines, line)
+	}
+	flush()
+	return rows
+}
+
+func (p *tableParser) parseRow(lines []parsedLine, separator *parsedLine, prevRow Row) Row {
+	numColumns := len(p.boundaries) - 1
+	row := make(Row, numColumns)
+	for colIdx := 0; colIdx < numColumns; {
+		// find the columns merged with this one
+		colIdxEnd := colIdx + 1
+		for colIdxEnd < numColumns && lines[0].charAt(p.boundaries[colIdxEnd]) != p.box.MiddleVertical {
+			colIdxEnd++
+		}
+
+		cellLines := make([]string, len(lines))
+		for lineIdx, line := range lines {
+			cellLines[lineIdx] = strings.TrimSpace(line.segment(p.boundaries[colIdx]+1, p.boundaries[colIdxEnd]))
+		}
+		cell := strings.Trim(strings.Join(cellLines, "\n"), "\n")
+		for ; colIdx < colIdxEnd; colIdx++ {
+			row[colIdx] = cell
+			if cell == "" && prevRow != nil && separator != nil && p.isMergedVertically(*separator, colIdx) {
+				row[colIdx] = prevRow[colIdx]
+			}
+		}
+	}
+	return row
+}
+
+func (p *tableParser) isFormatted(rows []Row, format text.Format) bool {
+	for _, row := range rows {
+		for _, cell := range row {
+			if str := cell.(string); format.Apply(str) != str {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// isMergedVertically returns true if the separator has no horizontal line in
+// the given column.
+func (p *tableParser) isMergedVertically(separator parsedLine, colIdx int) bool {
+	segment := separator.segment(p.boundaries[colIdx]+1, p.boundaries[colIdx+1])
+	return segment != "" && strings.TrimSpace(segment) == ""
+}
@@ -0,0 +1,143 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tinybit/go-pretty/v6/text"
+)
+
+func TestDetectStyle(t *testing.T) {
+	for _, style := range []Style{StyleBold, StyleDefault, StyleDouble, StyleLight, StyleRounded} {
+		tw := NewWriter()
+		tw.AppendHeader(testHeader)
+		tw.AppendRows(testRows)
+		tw.SetStyle(style)
+
+		detectedStyle, ok := DetectStyle("\n" + tw.Render())
+		assert.True(t, ok, style.Name)
+		assert.Equal(t, style.Name, detectedStyle.Name)
+	}
+
+	_, ok := DetectStyle("foo")
+	assert.False(t, ok)
+}
+
+func TestParse(t *testing.T) {
+	for _, style := range []Style{StyleBold, StyleColoredBright, StyleDefault, StyleDouble, StyleLight, StyleRounded} {
+		tw := NewWriter()
+		tw.AppendHeader(testHeader)
+		tw.AppendRows(testRows)
+		tw.AppendRow(testRowMultiLine)
+		tw.AppendSeparator()
+		tw.AppendRow(Row{"", "Sansa", "Stark", 1000, ""})
+		tw.AppendFooter(testFooter)
+		tw.SetCaption(testCaption)
+		tw.SetTitle(testTitle1)
+		tw.SetStyle(style)
+		if style.Name == StyleColoredBright.Name {
+			tw.Style().Box = StyleBoxLight
+			tw.Style().Options = OptionsDefault
+		}
+
+		pt, err := Parse(tw.Render(), Style{})
+		assert.Nil(t, err, style.Name)
+		if pt == nil {
+			continue
+		}
+		assert.Equal(t, testTitle1, pt.Title, style.Name)
+		assert.Equal(t, testCaption, pt.Caption, style.Name)
+		assert.Equal(t, []Row{{"#", "FIRST NAME", "LAST NAME", "SALARY", ""}}, pt.Header, style.Name)
+		assert.Equal(t, []Row{
+			{"1", "Arya", "Stark", "3000", ""},
+			{"20", "Jon", "Snow", "2000", "You know nothing, Jon Snow!"},
+			{"300", "Tyrion", "Lannister", "5000", ""},
+			{"0", "Winter", "Is", "0", "Coming.\nThe North Remembers!\nThis is known."},
+			{"", "Sansa", "Stark", "1000", ""},
+		}, pt.Rows, style.Name)
+		assert.Equal(t, []Row{{"", "", "TOTAL", "10000", ""}}, pt.Footer, style.Name)
+	}
+}
+
+func TestParse_AutoMerge(t *testing.T) {
+	rcAutoMerge := RowConfig{AutoMerge: true}
+	tw := NewWriter()
+	tw.AppendHeader(Row{"Node IP", "Pods", "Namespace", "Container", "RCE\nEXE", "RCE\nRUN"})
+	tw.AppendRow(Row{"1.1.1.1", "Pod 1A", "NS 1A", "C 1", "Y", "Y"}, rcAutoMerge)
+	tw.AppendRow(Row{"1.1.1.1", "Pod 1A", "NS 1A", "C 2", "Y", "N"}, rcAutoMerge)
+	tw.AppendRow(Row{"1.1.1.1", "Pod 1B", "NS 1B", "C 3", "N", "N"}, rcAutoMerge)
+	tw.SetColumnConfigs([]ColumnConfig{{Number: 1, AutoMerge: true}, {Number: 2, AutoMerge: true}})
+	tw.SetStyle(StyleLight)
+	tw.Style().Options.SeparateRows = true
+
+	pt, err := Parse(tw.Render(), *tw.Style())
+	assert.Nil(t, err)
+	assert.Equal(t, []Row{
+		{"NODE IP", "PODS", "NAMESPACE", "CONTAINER", "RCE\nEXE", "RCE\nRUN"},
+	}, pt.Header)
+	assert.Equal(t, []Row{
+		{"1.1.1.1", "Pod 1A", "NS 1A", "C 1", "Y", "Y"},
+		{"1.1.1.1", "Pod 1A", "NS 1A", "C 2", "Y", "N"},
+		{"1.1.1.1", "Pod 1B", "NS 1B", "C 3", "N", "N"},
+	}, pt.Rows)
+	assert.Nil(t, pt.Footer)
+}
+
+func TestParse_Errors(t *testing.T) {
+	pt, err := Parse("foo\nbar", Style{})
+	assert.Nil(t, pt)
+	assert.Equal(t, ErrParseUnknownStyle, err)
+
+	pt, err = Parse("foo\nbar", StyleDefault)
+	assert.Nil(t, pt)
+	assert.Equal(t, ErrParseNoTable, err)
+}
+
+func TestParse_RoundTrip(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"Name", "House", "Sigil"})
+	tw.AppendRow(Row{"Arya", "Stark", "Direwolf"})
+	tw.AppendRow(Row{"Daenerys", "Targaryen", "Three-headed\ndragon"})
+	tw.AppendRow(Row{"Tyrion", "Lannister", "Lion"})
+	tw.AppendRow(Row{"Bran", "Stark", "乌鸦"})
+	tw.AppendFooter(Row{"", "Houses", "3"})
+	tw.SetStyle(StyleRounded)
+	tw.Style().Color.Header = text.Colors{text.Bold}
+	out := tw.Render()
+
+	pt, err := Parse(out, Style{})
+	assert.Nil(t, err)
+	tw2 := NewWriter()
+	for _, row := range pt.Header {
+		tw2.AppendHeader(row)
+	}
+	tw2.AppendRows(pt.Rows)
+	for _, row := range pt.Footer {
+		tw2.AppendFooter(row)
+	}
+	tw2.SetStyle(pt.Style)
+	tw2.Style().Color.Header = text.Colors{text.Bold}
+	assert.Equal(t, out, tw2.Render())
+}
+
+func TestParse_RoundTripSingleLineRows(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"Name", "House"})
+	tw.AppendRow(Row{"Arya", "Stark"})
+	tw.AppendRow(Row{"", "Targaryen"})
+	tw.AppendRow(Row{"Tyrion", ""})
+	out := tw.Render()
+
+	pt, err := Parse(out, Style{})
+	assert.Nil(t, err)
+	assert.Equal(t, []Row{{"Arya", "Stark\nTargaryen"}, {"Tyrion", ""}}, pt.Rows)
+
+	pt, err = Parse(out, Style{}, ParseSingleLineRows())
+	assert.Nil(t, err)
+	assert.Equal(t, []Row{{"Arya", "Stark"}, {"", "Targaryen"}, {"Tyrion", ""}}, pt.Rows)
+	tw2 := NewWriter()
+	tw2.AppendHeader(pt.Header[0])
+	tw2.AppendRows(pt.Rows)
+	tw2.SetStyle(pt.Style)
+	assert.Equal(t, out, tw2.Render())
+}
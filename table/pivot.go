@@ -0,0 +1,267 @@
+package table
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/tinybit/go-pretty/v6/text"
+)
+
+// PivotTotalLabel is the label used for the row and column totals in the
+// Tables generated by Pivot.
+var PivotTotalLabel = "Total"
+
+// Pivot reshapes the "long-format" data in the src Table into a cross-tab with
+// the distinct values of the "rows" columns down the side and the distinct
+// values of the "cols" columns across the top, and with each cell containing
+// the "values" column of all the matching Rows summarized using agg (defaults
+// to AggregateSum). For example, a Table with the columns "Region", "Year" and
+// "Sales" becomes:
+//
+//	pivot := table.Pivot(tw, []string{"Region"}, []string{"Year"}, "Sales", table.AggregateSum)
+//	+--------+------+------+-------+
+//	| REGION | 2023 | 2024 | TOTAL |
+//	+--------+------+------+-------+
+//	| North  |  300 |  400 |   700 |
+//	| South  |  150 |      |   150 |
+//	+--------+------+------+-------+
+//	| TOTAL  |  450 |  400 |   850 |
+//	+--------+------+------+-------+
+//
+// The columns are identified by their names in the first Header row of src,
+// and all the Rows of src are considered (FilterBy is not applied). Multiple
+// "cols" result in multiple Header rows with the labels of the outer levels
+// spanning all the columns under them. The labels on both the axes are sorted
+// (numerically if possible), and the row and column totals are computed using
+// agg on the underlying values (and not on the summarized values).
+//
+// An empty Table is returned if src was not created using NewWriter, or if any
+// of the columns cannot be found.
+func Pivot(src Writer, rows []string, cols []string, values string, agg AggregateFunc) Writer {
+	tw := NewWriter()
+	srcTable, ok := src.(*Table)
+	if !ok || len(srcTable.rowsHeaderRaw) == 0 {
+		return tw
+	}
+	if agg == nil {
+		agg = AggregateSum
+	}
+
+	// find the columns to pivot on
+	rowsColIdx, ok1 := pivotGetColumnIndices(srcTable.rowsHeaderRaw[0], rows)
+	colsColIdx, ok2 := pivotGetColumnIndices(srcTable.rowsHeaderRaw[0], cols)
+	valuesColIdx, ok3 := pivotGetColumnIndices(srcTable.rowsHeaderRaw[0], []string{values})
+	if !ok1 || !ok2 || !ok3 {
+		return tw
+	}
+
+	// collect the values for each combination of the row and column labels
+	p := pivot{
+		cells:     make(map[string][]interface{}),
+		colTotals: make(map[string][]interface{}),
+		rowTotals: make(map[string][]interface{}),
+	}
+	for _, row := range srcTable.rowsRaw {
+		rowKey := p.rowKeys.add(pivotGetLabels(row, rowsColIdx))
+		colKey := p.colKeys.add(pivotGetLabels(row, colsColIdx))
+		value := pivotGetLabels(row, valuesColIdx)[0]
+		p.cells[rowKey+"\x00"+colKey] = append(p.cells[rowKey+"\x00"+colKey], value)
+		p.colTotals[colKey] = append(p.colTotals[colKey], value)
+		p.rowTotals[rowKey] = append(p.rowTotals[rowKey], value)
+		p.total = append(p.total, value)
+	}
+	if len(p.total) == 0 {
+		return tw
+	}
+	p.rowKeys.sort()
+	p.colKeys.sort()
+
+	p.appendHeader(tw, rows, cols, values)
+	for _, rowKey := range p.rowKeys.keys {
+		row := append(Row{}, rowKey.values...)
+		for _, colKey := range p.colKeys.keys {
+			if cellValues, ok := p.cells[rowKey.key+"\x00"+colKey.key]; ok {
+				row = append(row, pivotValueOrEmpty(agg(cellValues)))
+			} else {
+				row = append(row, "")
+			}
+		}
+		if len(cols) > 0 {
+			row = append(row, pivotValueOrEmpty(agg(p.rowTotals[rowKey.key])))
+		}
+		tw.AppendRow(row)
+	}
+	if len(rows) > 0 {
+		row := Row{Cell{Value: PivotTotalLabel, ColSpan: len(rows)}}
+		for _, colKey := range p.colKeys.keys {
+			row = append(row, pivotValueOrEmpty(agg(p.colTotals[colKey.key])))
+		}
+		if len(cols) > 0 {
+			row = append(row, pivotValueOrEmpty(agg(p.total)))
+		}
+		tw.AppendFooter(row)
+	}
+
+	// right-align all the summarized values as the empty cells would make the
+	// columns look non-numeric otherwise
+	var columnConfigs []ColumnConfig
+	numValueColumns := len(p.colKeys.keys)
+	if len(cols) > 0 {
+		numValueColumns++
+	}
+	for colIdx := 0; colIdx < numValueColumns; colIdx++ {
+		columnConfigs = append(columnConfigs, ColumnConfig{
+			Number:      len(rows) + colIdx + 1,
+			Align:       text.AlignRight,
+			AlignFooter: text.AlignRight,
+		})
+	}
+	tw.SetColumnConfigs(columnConfigs)
+	return tw
+}
+
+type pivot struct {
+	cells     map[string][]interface{}
+	colKeys   pivotKeys
+	colTotals map[string][]interface{}
+	rowKeys   pivotKeys
+	rowTotals map[string][]interface{}
+	total     []interface{}
+}
+
+// pivotKey is the combination of the labels from one or more columns.
+type pivotKey struct {
+	key    string
+	labels []string
+	values []interface{}
+}
+
+// pivotKeys is the list of unique keys in the order they were added (until
+// sorted), along with the index of each key in the list for quick look-ups.
+type pivotKeys struct {
+	indices map[string]int
+	keys    []pivotKey
+}
+
+// add adds the key for the given values if not added already, and returns it.
+func (pks *pivotKeys) add(values []interface{}) string {
+	labels := make([]string, len(values))
+	for idx, value := range values {
+		labels[idx] = convertValueToString(value)
+	}
+	key := strings.Join(labels, "\x00")
+	if _, ok := pks.indices[key]; ok {
+		return key
+	}
+	if pks.indices == nil {
+		pks.indices = make(map[string]int)
+	}
+	pks.indices[key] = len(pks.keys)
+	pks.keys = append(pks.keys, pivotKey{key: key, labels: labels, values: values})
+	return key
+}
+
+// appendHeader appends the Header row(s) with the names of the "rows" columns,
+// the labels of the "cols" columns (one row per column), and the total.
+func (p *pivot) appendHeader(tw Writer, rows []string, cols []string, values string) {
+	numHeaderRows := len(cols)
+	if numHeaderRows == 0 {
+		row := Row{}
+		for _, name := range rows {
+			row = append(row, name)
+		}
+		tw.AppendHeader(append(row, values))
+		return
+	}
+
+	for level := 0; level < numHeaderRows; level++ {
+		row := Row{}
+		if level == 0 {
+			for _, name := range rows {
+				row = append(row, Cell{Value: name, RowSpan: numHeaderRows})
+			}
+		}
+		for colIdx := 0; colIdx < len(p.colKeys.keys); {
+			// the labels of the outer levels span all the columns under them
+			colSpan := 1
+			for colIdx+colSpan < len(p.colKeys.keys) && level < numHeaderRows-1 &&
+				p.colKeys.keys[colIdx].hasSamePrefix(p.colKeys.keys[colIdx+colSpan], level+1) {
+				colSpan++
+			}
+			row = append(row, Cell{Value: p.colKeys.keys[colIdx].values[level], ColSpan: colSpan})
+			colIdx += colSpan
+		}
+		if level == 0 {
+			row = append(row, Cell{Value: PivotTotalLabel, RowSpan: numHeaderRows})
+		}
+		tw.AppendHeader(row)
+	}
+}
+
+func (pk pivotKey) hasSamePrefix(other pivotKey, length int) bool {
+	for idx := 0; idx < length; idx++ {
+		if pk.labels[idx] != other.labels[idx] {
+			return false
+		}
+	}
+	return true
+}
+
+func (pks *pivotKeys) sort() {
+	sort.SliceStable(pks.keys, func(i, j int) bool {
+		for idx := range pks.keys[i].values {
+			if cmp := pivotCompare(pks.keys[i].values[idx], pks.keys[j].values[idx]); cmp != 0 {
+				return cmp < 0
+			}
+		}
+		return false
+	})
+	for idx, pk := range pks.keys {
+		pks.indices[pk.key] = idx
+	}
+}
+
+// pivotCompare compares the values numerically if possible, and in their
+// string form otherwise.
+func pivotCompare(a interface{}, b interface{}) int {
+	numA, okA := aggregateNumber(a)
+	numB, okB := aggregateNumber(b)
+	if okA && okB {
+		if numA < numB {
+			return -1
+		} else if numA > numB {
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(convertValueToString(a), convertValueToString(b))
+}
+
+func pivotGetColumnIndices(header Row, names []string) ([]int, bool) {
+	colIndices := make([]int, len(names))
+	for idx, name := range names {
+		colNum := header.findColumnNumber(name)
+		if colNum == 0 {
+			return nil, false
+		}
+		colIndices[idx] = colNum - 1
+	}
+	return colIndices, true
+}
+
+func pivotGetLabels(row Row, colIndices []int) []interface{} {
+	values := make([]interface{}, len(colIndices))
+	for idx, colIdx := range colIndices {
+		if colIdx < len(row) {
+			values[idx] = row[colIdx]
+		}
+	}
+	return values
+}
+
+func pivotValueOrEmpty(value interface{}) interface{} {
+	if value == nil {
+		return ""
+	}
+	return value
+}
@@ -0,0 +1,94 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func pivotTestSource() Writer {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"Region", "Product", "Year", "Sales"})
+	tw.AppendRows([]Row{
+		{"South", "Widget", 2024, 100},
+		{"North", "Widget", 2023, 300},
+		{"North", "Gadget", 2024, 400},
+		{"South", "Gadget", 2023, 150},
+		{"North", "Widget", 2023, 50},
+		{"South", "Widget", 2023, 25},
+	})
+	return tw
+}
+
+func TestPivot(t *testing.T) {
+	t.Run("rows and cols", func(t *testing.T) {
+		tw := Pivot(pivotTestSource(), []string{"Region"}, []string{"Year"}, "Sales", AggregateSum)
+
+		compareOutput(t, tw.Render(), `
++--------+------+------+-------+
+| REGION | 2023 | 2024 | TOTAL |
++--------+------+------+-------+
+| North  |  350 |  400 |   750 |
+| South  |  175 |  100 |   275 |
++--------+------+------+-------+
+| TOTAL  |  525 |  500 |  1025 |
++--------+------+------+-------+`)
+	})
+
+	t.Run("multi-level cols", func(t *testing.T) {
+		tw := Pivot(pivotTestSource(), []string{"Region"}, []string{"Year", "Product"}, "Sales", AggregateCount)
+
+		compareOutput(t, tw.Render(), `
++--------+-----------------+-----------------+-------+
+| REGION | 2023            | 2024            | TOTAL |
+|        | GADGET | WIDGET | GADGET | WIDGET |       |
++--------+--------+--------+--------+--------+-------+
+| North  |        |      2 |      1 |        |     3 |
+| South  |      1 |      1 |        |      1 |     3 |
++--------+--------+--------+--------+--------+-------+
+| TOTAL  |      1 |      3 |      1 |      1 |     6 |
++--------+--------+--------+--------+--------+-------+`)
+	})
+
+	t.Run("multi-level rows", func(t *testing.T) {
+		tw := Pivot(pivotTestSource(), []string{"Region", "Product"}, []string{"Year"}, "Sales", nil)
+
+		compareOutput(t, tw.Render(), `
++--------+---------+------+------+-------+
+| REGION | PRODUCT | 2023 | 2024 | TOTAL |
++--------+---------+------+------+-------+
+| North  | Gadget  |      |  400 |   400 |
+| North  | Widget  |  350 |      |   350 |
+| South  | Gadget  |  150 |      |   150 |
+| South  | Widget  |   25 |  100 |   125 |
++--------+---------+------+------+-------+
+| TOTAL            |  525 |  500 |  1025 |
++------------------+------+------+-------+`)
+	})
+
+	t.Run("no cols", func(t *testing.T) {
+		tw := Pivot(pivotTestSource(), []string{"Product"}, nil, "Sales", AggregateMax)
+
+		compareOutput(t, tw.Render(), `
++---------+-------+
+| PRODUCT | SALES |
++---------+-------+
+| Gadget  |   400 |
+| Widget  |   300 |
++---------+-------+
+| TOTAL   |   400 |
++---------+-------+`)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		assert.Empty(t, Pivot(pivotTestSource(), []string{"Region"}, []string{"Month"}, "Sales", nil).Render())
+		assert.Empty(t, Pivot(pivotTestSource(), []string{"Region"}, []string{"Year"}, "Profit", nil).Render())
+		assert.Empty(t, Pivot(NewWriter(), []string{"Region"}, []string{"Year"}, "Sales", nil).Render())
+	})
+}
+
+func TestPivotCompare(t *testing.T) {
+	assert.Equal(t, -1, pivotCompare(9, "10"))
+	assert.Equal(t, 1, pivotCompare("9", "10x"))
+	assert.Equal(t, 0, pivotCompare(2.0, 2))
+}
@@ -21,6 +21,12 @@ import (
 //	└─────┴────────────┴───────────┴────────┴─────────────────────────────┘
 func (t *Table) Render() string {
 	t.initForRender(renderModeDefault)
+	if t.shouldRenderExpanded() {
+		return t.renderExpanded()
+	}
+	if panes := t.getSplitWidePanes(); len(panes) > 1 {
+		return t.renderSplitWide(panes)
+	}
 
 	var out strings.Builder
 	if t.numColumns > 0 {
@@ -42,9 +48,9 @@ func (t *Table) Render() string {
 		t.renderRowsBorderBottom(&out)
 
 		// caption
-		if t.caption != "" {
+		if caption := t.getCaption(); caption != "" {
 			out.WriteRune('\n')
-			out.WriteString(t.caption)
+			out.WriteString(caption)
 		}
 	}
 	return t.render(&out)
@@ -81,7 +87,13 @@ func (t *Table) renderColumn(out *strings.Builder, row rowStr, colIdx int, maxCo
 	// have the same content and merge them all until a cell with a different
 	// content is found; override alignment to Center in this case
 	rowConfig := t.getRowConfig(hint)
-	if rowConfig.AutoMerge && !hint.isSeparatorRow {
+	if numColumns := t.getCellColSpan(colIdx, hint); numColumns > 1 && !hint.isSeparatorRow {
+		// merge all the cells that are a part of the same Cell
+		for idx := colIdx + 1; idx < colIdx+numColumns; idx++ {
+			maxColumnLength += t.getMaxColumnLengthForMerging(idx)
+			numColumnsRendered++
+		}
+	} else if rowConfig.AutoMerge && !hint.isSeparatorRow {
 		// get the real row to consider all lines in each column instead of just
 		// looking at the current "line"
 		rowUnwrapped := t.getRow(hint.rowNumber-1, hint)
@@ -149,7 +161,7 @@ func (t *Table) renderColumnColorized(out *strings.Builder, colIdx int, colStr s
 	} else if hint.isFooterRow && t.style.Color.Footer != nil {
 		out.WriteString(t.style.Color.Footer.Sprint(colStr))
 	} else if hint.isRegularRow() {
-		if colIdx == t.indexColumn-1 && t.style.Color.IndexColumn != nil {
+		if t.isIndexColumn(colIdx, hint) && t.style.Color.IndexColumn != nil {
 			out.WriteString(t.style.Color.IndexColumn.Sprint(colStr))
 		} else if hint.rowNumber%2 == 0 && t.style.Color.RowAlternate != nil {
 			out.WriteString(t.style.Color.RowAlternate.Sprint(colStr))
@@ -217,9 +229,9 @@ func (t *Table) renderLine(out *strings.Builder, row rowStr, hint renderHint) {
 		if t.pager.size > 0 && t.numLinesRendered%t.pager.size == 0 && !hint.isLastLineOfLastRow() {
 			t.renderRowsFooter(out)
 			t.renderRowsBorderBottom(out)
+			t.recordPageBreak(hint.rowNumber, hint.isLastLineOfRow)
 			out.WriteString(t.style.Box.PageSeparator)
-			t.renderRowsBorderTop(out)
-			t.renderRowsHeader(out)
+			t.renderPageTop(out)
 			t.firstRowOfPage = true
 		}
 	}
@@ -310,6 +322,30 @@ func (t *Table) renderRowSeparator(out *strings.Builder, hint renderHint) {
 	t.renderLine(out, t.rowSeparators[separator], hint)
 }
 
+// renderPageTop renders the top of a new page: the title if asked to repeat it
+// on every page, followed by the top border and (unless asked not to) the
+// header rows.
+func (t *Table) renderPageTop(out *strings.Builder) {
+	if t.pager.repeatsTitle() {
+		t.renderTitle(out)
+	}
+	if t.pager.repeatsHeader() {
+		t.renderRowsBorderTop(out)
+		t.renderRowsHeader(out)
+		return
+	}
+
+	st := separatorTypeRowTop
+	if t.pager.repeatsTitle() && t.title != "" {
+		st = separatorTypeTitleBottom
+	}
+	t.renderRowSeparator(out, renderHint{
+		isBorderTop:    true,
+		isSeparatorRow: true,
+		separatorType:  st,
+	})
+}
+
 func (t *Table) renderRows(out *strings.Builder, rows []rowStr, hint renderHint) {
 	for rowIdx, row := range rows {
 		hint.isFirstRow = rowIdx == 0
@@ -317,7 +353,7 @@ func (t *Table) renderRows(out *strings.Builder, rows []rowStr, hint renderHint)
 		hint.rowNumber = rowIdx + 1
 		t.renderRow(out, row, hint)
 
-		if t.shouldSeparateRows(rowIdx, len(rows)) {
+		if t.shouldSeparateRows(rowIdx, len(rows), hint) {
 			hintSep := hint
 			hintSep.isFirstRow = false
 			hintSep.isSeparatorRow = true
@@ -0,0 +1,152 @@
+package table
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tinybit/go-pretty/v6/text"
+)
+
+// RenderAsciiDoc renders the Table as an AsciiDoc table block. Example:
+//
+//	.Game of Thrones
+//	[cols=">,<,<,>,<",options="header,footer"]
+//	|===
+//	|# |First Name |Last Name |Salary |
+//
+//	|1 |Arya |Stark |3000 |
+//	|20 |Jon |Snow |2000 |You know nothing, Jon Snow!
+//	|300 |Tyrion |Lannister |5000 |
+//	| | |Total |10000 |
+//	|===
+//
+//	_A Song of Ice and Fire_
+//
+// The column alignments are derived from ColumnConfig (or the contents of the
+// column), the Title is rendered as the block title, and the Caption as an
+// emphasized paragraph below the table. Cells merged horizontally using
+// RowConfig.AutoMerge or Cell.ColSpan are rendered with a column-span. Like in
+// Markdown, manual separators are ignored. ANSI escape sequences are stripped.
+//
+// AsciiDoc supports only one footer row; so all but the last Footer row will
+// be rendered as regular rows.
+func (t *Table) RenderAsciiDoc() string {
+	t.initForRender(renderModeAsciiDoc)
+
+	var out strings.Builder
+	if t.numColumns > 0 {
+		if t.title != "" {
+			out.WriteRune('.')
+			out.WriteString(strings.Join(strings.Fields(t.title), " "))
+			out.WriteRune('\n')
+		}
+		t.asciiDocRenderAttributes(&out)
+		out.WriteString("|===")
+		if len(t.rowsHeader) > 0 {
+			t.asciiDocRenderRows(&out, t.rowsHeader, renderHint{isHeaderRow: true})
+			out.WriteRune('\n')
+		} else if t.autoIndex {
+			t.asciiDocRenderRows(&out, []rowStr{t.getAutoIndexColumnIDs()}, renderHint{isAutoIndexRow: true, isHeaderRow: true})
+			out.WriteRune('\n')
+		}
+		t.asciiDocRenderRows(&out, t.rows, renderHint{})
+		t.asciiDocRenderRows(&out, t.rowsFooter, renderHint{isFooterRow: true})
+		out.WriteString("\n|===")
+		if t.caption != "" {
+			out.WriteString("\n\n_")
+			out.WriteString(strings.Join(strings.Fields(t.caption), " "))
+			out.WriteRune('_')
+		}
+	}
+	return t.render(&out)
+}
+
+func (t *Table) asciiDocAlign(align text.Align) string {
+	switch align {
+	case text.AlignCenter:
+		return "^"
+	case text.AlignRight:
+		return ">"
+	default:
+		return "<"
+	}
+}
+
+func (t *Table) asciiDocRenderAttributes(out *strings.Builder) {
+	var cols []string
+	if t.autoIndex {
+		cols = append(cols, ">")
+	}
+	for colIdx := 0; colIdx < t.numColumns; colIdx++ {
+		cols = append(cols, t.asciiDocAlign(t.getAlign(colIdx, renderHint{})))
+	}
+
+	var options []string
+	if len(t.rowsHeader) > 0 || t.autoIndex {
+		options = append(options, "header")
+	}
+	if len(t.rowsFooter) > 0 {
+		options = append(options, "footer")
+	}
+
+	out.WriteString("[cols=\"")
+	out.WriteString(strings.Join(cols, ","))
+	out.WriteRune('"')
+	if len(options) > 0 {
+		out.WriteString(",options=\"")
+		out.WriteString(strings.Join(options, ","))
+		out.WriteRune('"')
+	}
+	out.WriteString("]\n")
+}
+
+func (t *Table) asciiDocRenderCell(out *strings.Builder, colStr string, numColumns int) {
+	if numColumns > 1 {
+		out.WriteString(fmt.Sprintf("%d+", numColumns))
+	}
+	out.WriteRune('|')
+
+	colStr = strings.ReplaceAll(text.StripEscape(colStr), "|", "\\|")
+	// hard line-breaks for multi-line content
+	colStr = strings.ReplaceAll(colStr, "\n", " +\n")
+	out.WriteString(colStr)
+}
+
+func (t *Table) asciiDocRenderRow(out *strings.Builder, row rowStr, hint renderHint) {
+	out.WriteRune('\n')
+	if t.autoIndex {
+		if hint.isRegularRow() {
+			out.WriteString(fmt.Sprintf("|%d ", hint.rowNumber))
+		} else {
+			out.WriteString("| ")
+		}
+	}
+
+	rowConfig := t.getRowConfig(hint)
+	for colIdx := 0; colIdx < t.numColumns; colIdx++ {
+		if colIdx > 0 {
+			out.WriteRune(' ')
+		}
+		var colStr string
+		if colIdx < len(row) {
+			colStr = row[colIdx]
+		}
+
+		// look ahead and merge all the cells with the same content
+		numColumns := t.getCellColSpan(colIdx, hint)
+		if numColumns == 1 && rowConfig.AutoMerge {
+			for row.areEqual(colIdx, colIdx+numColumns) {
+				numColumns++
+			}
+		}
+		t.asciiDocRenderCell(out, colStr, numColumns)
+		colIdx += numColumns - 1
+	}
+}
+
+func (t *Table) asciiDocRenderRows(out *strings.Builder, rows []rowStr, hint renderHint) {
+	for rowIdx, row := range rows {
+		hint.rowNumber = rowIdx + 1
+		t.asciiDocRenderRow(out, row, hint)
+	}
+}
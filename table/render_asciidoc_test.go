@@ -0,0 +1,61 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tinybit/go-pretty/v6/text"
+)
+
+func TestTable_RenderAsciiDoc(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(testHeader)
+	tw.AppendRows(testRows)
+	tw.AppendRow(testRowMultiLine)
+	tw.AppendRow(testRowPipes)
+	tw.AppendSeparator()
+	tw.AppendFooter(testFooter)
+	tw.SetCaption(testCaption)
+	tw.SetColumnConfigs([]ColumnConfig{{Name: "First Name", Align: text.AlignCenter}})
+	tw.SetTitle(testTitle1)
+
+	compareOutput(t, tw.RenderAsciiDoc(), `
+.Game of Thrones
+[cols=">,^,<,>,<",options="header,footer"]
+|===
+|# |First Name |Last Name |Salary |
+
+|1 |Arya |Stark |3000 |
+|20 |Jon |Snow |2000 |You know nothing, Jon Snow!
+|300 |Tyrion |Lannister |5000 |
+|0 |Winter |Is |0 |Coming. +
+The North Remembers! +
+This is known.
+|0 |Valar |Morghulis |0 |Faceless\|Men
+| | |Total |10000 |
+|===
+
+_A Song of Ice and Fire_`)
+}
+
+func TestTable_RenderAsciiDoc_AutoIndexAndMerge(t *testing.T) {
+	rcAutoMerge := RowConfig{AutoMerge: true}
+	tw := NewWriter()
+	tw.AppendRow(Row{"1.1.1.1", "Pod 1A", "NS 1A", "C 1", "Y", "Y"}, rcAutoMerge)
+	tw.AppendRow(Row{"1.1.1.1", "Pod 1A", "NS 1A", "C 2", "Y", "N"}, rcAutoMerge)
+	tw.SetAutoIndex(true)
+
+	compareOutput(t, tw.RenderAsciiDoc(), `
+[cols=">,<,<,<,<,<,<",options="header"]
+|===
+| |A |B |C |D |E |F
+
+|1 |1.1.1.1 |Pod 1A |NS 1A |C 1 2+|Y
+|2 |1.1.1.1 |Pod 1A |NS 1A |C 2 |Y |N
+|===`)
+}
+
+func TestTable_RenderAsciiDoc_Empty(t *testing.T) {
+	tw := NewWriter()
+	assert.Empty(t, tw.RenderAsciiDoc())
+}
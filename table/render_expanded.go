@@ -0,0 +1,328 @@
+package table
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tinybit/go-pretty/v6/text"
+)
+
+var (
+	// ExpandedFooterLabel is the label in the separator above each Footer row
+	// when rendering in the expanded mode.
+	ExpandedFooterLabel = "FOOTER"
+	// ExpandedRecordFormat is the format of the label in the separator above
+	// each Row when rendering in the expanded mode; it is given the 1-indexed
+	// number of the Row.
+	ExpandedRecordFormat = "RECORD %d"
+)
+
+// expandedRecord is a Row (or a Footer row, or a group header/subtotal row) to
+// be rendered as a block of "<column name> | <value>" lines in the expanded
+// mode.
+type expandedRecord struct {
+	hint   renderHint
+	label  string
+	values rowStr // nil for group header rows which render just the separator
+}
+
+// renderExpanded renders the Table with each Row as a block of lines, one per
+// column, below a separator containing the Row number. Example:
+//
+//	+-[ RECORD 1 ]-----------------------------+
+//	| #          | 1                           |
+//	| FIRST NAME | Arya                        |
+//	| LAST NAME  | Stark                       |
+//	| SALARY     | 3000                        |
+//	| E          |                             |
+//	+-[ RECORD 2 ]-----------------------------+
+//	...
+func (t *Table) renderExpanded() string {
+	names := t.getExpandedColumnNames()
+	records := t.getExpandedRecords()
+	widthName, widthValue := t.getExpandedWidths(names, records)
+
+	// the title spans the whole width like it does usually
+	t.maxRowLength = t.getExpandedRowLength(widthName, widthValue)
+
+	var out strings.Builder
+	t.renderTitle(&out)
+	numRecordsRendered, lastRowNumber := 0, 0
+	for idx, record := range records {
+		hintSeparator := renderHint{
+			isHeaderRow:    true,
+			isSeparatorRow: true,
+			separatorType:  separatorTypeRowMiddle,
+		}
+		if t.firstRowOfPage {
+			hintSeparator.isBorderTop = true
+			hintSeparator.separatorType = separatorTypeRowTop
+			if t.title != "" && (idx == 0 || t.pager.repeatsTitle()) {
+				hintSeparator.separatorType = separatorTypeTitleBottom
+			}
+		}
+		t.firstRowOfPage = false
+		t.renderExpandedSeparator(&out, record.label, widthName, widthValue, hintSeparator)
+		if record.values == nil {
+			continue
+		}
+
+		for colIdx, name := range names {
+			var value string
+			if colIdx < len(record.values) {
+				value = record.values[colIdx]
+			}
+			if text.LongestLineLen(value) > widthValue {
+				value = text.WrapSoft(value, widthValue)
+			}
+			for lineIdx, line := range strings.Split(value, "\n") {
+				if lineIdx > 0 {
+					name = ""
+				}
+				t.renderExpandedLine(&out, colIdx, name, line, widthName, widthValue, record.hint)
+			}
+		}
+
+		// honor the page size (if any) by starting a new page after every N
+		// records instead of every N lines
+		numRecordsRendered++
+		if !record.hint.isFooterRow && record.hint.rowNumber > 0 {
+			lastRowNumber = record.hint.rowNumber
+		}
+		if t.pager.size > 0 && numRecordsRendered%t.pager.size == 0 && idx < len(records)-1 {
+			t.renderExpandedSeparator(&out, "", widthName, widthValue, renderHint{isBorderBottom: true, isSeparatorRow: true, separatorType: separatorTypeRowBottom})
+			t.recordPageBreak(lastRowNumber, true)
+			out.WriteString(t.style.Box.PageSeparator)
+			if t.pager.repeatsTitle() {
+				t.renderTitle(&out)
+			}
+			t.firstRowOfPage = true
+		}
+	}
+	if len(records) > 0 {
+		t.renderExpandedSeparator(&out, "", widthName, widthValue, renderHint{isBorderBottom: true, isSeparatorRow: true, separatorType: separatorTypeRowBottom})
+	}
+	if caption := t.getCaption(); caption != "" {
+		out.WriteRune('\n')
+		out.WriteString(caption)
+	}
+	return t.render(&out)
+}
+
+func (t *Table) renderExpandedLine(out *strings.Builder, colIdx int, name string, value string, widthName int, widthValue int, hint renderHint) {
+	if out.Len() > 0 {
+		out.WriteRune('\n')
+	}
+
+	var outLine strings.Builder
+	t.renderMarginLeft(&outLine, hint)
+	nameStr := t.style.Box.PaddingLeft + text.AlignLeft.Apply(name, widthName) + t.style.Box.PaddingRight
+	t.renderColumnColorized(&outLine, colIdx, nameStr, renderHint{isHeaderRow: true})
+	if t.style.Options.SeparateColumns {
+		outLine.WriteString(t.getSeparatorColors(hint).Sprint(t.style.Box.MiddleVertical))
+	}
+	valueStr := t.style.Box.PaddingLeft + text.AlignLeft.Apply(value, widthValue) + t.style.Box.PaddingRight
+	t.renderColumnColorized(&outLine, colIdx, valueStr, hint)
+	t.renderMarginRight(&outLine, hint)
+
+	t.renderExpandedLineMergeOutputs(out, &outLine)
+}
+
+func (t *Table) renderExpandedLineMergeOutputs(out *strings.Builder, outLine *strings.Builder) {
+	if t.style.Size.WidthMax > 0 {
+		t.renderLineMergeOutputs(out, outLine)
+	} else {
+		out.WriteString(outLine.String())
+	}
+}
+
+func (t *Table) renderExpandedSeparator(out *strings.Builder, label string, widthName int, widthValue int, hint renderHint) {
+	if label == "" && !t.style.Options.DrawBorder {
+		return
+	}
+	if out.Len() > 0 {
+		out.WriteRune('\n')
+	}
+
+	left, middle, right := t.style.Box.LeftSeparator, t.style.Box.MiddleSeparator, t.style.Box.RightSeparator
+	if hint.isBorderTop {
+		if t.title != "" {
+			middle = t.style.Box.TopSeparator
+		} else {
+			left, middle, right = t.style.Box.TopLeft, t.style.Box.TopSeparator, t.style.Box.TopRight
+		}
+	} else if hint.isBorderBottom {
+		left, middle, right = t.style.Box.BottomLeft, t.style.Box.BottomSeparator, t.style.Box.BottomRight
+	}
+	if !t.style.Options.SeparateColumns {
+		middle = ""
+	}
+
+	lenPadding := text.StringWidthWithoutEscSequences(t.style.Box.PaddingLeft + t.style.Box.PaddingRight)
+	horizontal := t.style.Box.middleHorizontal(hint.separatorType)
+	line := []rune(text.RepeatAndTrim(horizontal, widthName+lenPadding) + middle +
+		text.RepeatAndTrim(horizontal, widthValue+lenPadding))
+	if label != "" && len(line) > 0 {
+		// overlay the label on the line after the first horizontal character
+		labelRunes := []rune("[ " + label + " ]")
+		lineWithLabel := append([]rune{line[0]}, labelRunes...)
+		if len(lineWithLabel) < len(line) {
+			lineWithLabel = append(lineWithLabel, line[len(lineWithLabel):]...)
+		}
+		line = lineWithLabel
+	}
+
+	var outLine strings.Builder
+	colors := t.getBorderColors(hint)
+	if t.style.Options.DrawBorder {
+		outLine.WriteString(colors.Sprint(left))
+	}
+	outLine.WriteString(colors.Sprint(string(line)))
+	if t.style.Options.DrawBorder {
+		outLine.WriteString(colors.Sprint(right))
+	}
+
+	t.renderExpandedLineMergeOutputs(out, &outLine)
+}
+
+// getExpandedColumnNames returns the name of each column as it appears in the
+// Header row(s), or the auto-index column ID if the name is empty.
+func (t *Table) getExpandedColumnNames() []string {
+	names := make([]string, t.numColumns)
+	for colIdx := range names {
+		var parts []string
+		for _, row := range t.rowsHeader {
+			if colIdx < len(row) && row[colIdx] != "" {
+				parts = append(parts, strings.ReplaceAll(row[colIdx], "\n", " "))
+			}
+		}
+		name := strings.Join(parts, " ")
+		if name == "" {
+			name = AutoIndexColumnID(colIdx)
+		}
+		names[colIdx] = t.style.Format.Header.Apply(name)
+	}
+	return names
+}
+
+// getExpandedRecords returns the Rows and the Footer rows to be rendered in the
+// expanded mode; the group header rows turn into separators with the header
+// text, and the subtotal rows into records labeled with the subtotal label.
+func (t *Table) getExpandedRecords() []expandedRecord {
+	records := make([]expandedRecord, 0, len(t.rows)+len(t.rowsFooter))
+	recordNum := 0
+	for rowIdx, row := range t.rows {
+		hint := renderHint{rowNumber: rowIdx + 1}
+		record := expandedRecord{hint: hint, values: t.getExpandedValues(row, hint)}
+		if groupRow := t.getGroupRow(rowIdx); groupRow.groupBy == nil {
+			recordNum++
+			record.label = fmt.Sprintf(ExpandedRecordFormat, recordNum)
+		} else if groupRow.isSubtotal {
+			record.label = groupRow.groupBy.getSubtotalLabel()
+		} else {
+			for _, col := range row {
+				if col != "" {
+					record.label = strings.ReplaceAll(col, "\n", " ")
+					break
+				}
+			}
+			record.values = nil
+		}
+		records = append(records, record)
+	}
+	for rowIdx, row := range t.rowsFooter {
+		hint := renderHint{isFooterRow: true, rowNumber: rowIdx + 1}
+		records = append(records, expandedRecord{
+			hint:   hint,
+			label:  ExpandedFooterLabel,
+			values: t.getExpandedValues(row, hint),
+		})
+	}
+	return records
+}
+
+// getExpandedValues returns the values in the row formatted and with the
+// column width limits enforced.
+func (t *Table) getExpandedValues(row rowStr, hint renderHint) rowStr {
+	values := make(rowStr, len(row))
+	for colIdx, value := range row {
+		value = t.getFormat(hint).Apply(value)
+		if widthMax := t.getColumnWidthMax(colIdx); widthMax > 0 {
+			value = t.columnConfigMap[colIdx].getWidthMaxEnforcer()(value, widthMax)
+		}
+		values[colIdx] = value
+	}
+	return values
+}
+
+// getExpandedRowLength returns the length of each line given the widths of the
+// name and value columns.
+func (t *Table) getExpandedRowLength(widthName int, widthValue int) int {
+	rowLength := widthName + widthValue +
+		2*text.StringWidthWithoutEscSequences(t.style.Box.PaddingLeft+t.style.Box.PaddingRight)
+	if t.style.Options.SeparateColumns {
+		rowLength += text.StringWidthWithoutEscSequences(t.style.Box.MiddleVertical)
+	}
+	if t.style.Options.DrawBorder {
+		rowLength += text.StringWidthWithoutEscSequences(t.style.Box.Left + t.style.Box.Right)
+	}
+	return rowLength
+}
+
+// getExpandedWidths returns the widths of the name and value columns; the
+// value column is widened to fit the labels in the separators, and narrowed
+// (with the values wrapped) to fit within Style().Size.WidthMax.
+func (t *Table) getExpandedWidths(names []string, records []expandedRecord) (int, int) {
+	widthName, widthValue := 0, 0
+	for _, name := range names {
+		if width := text.StringWidthWithoutEscSequences(name); width > widthName {
+			widthName = width
+		}
+	}
+	for _, record := range records {
+		for _, value := range record.values {
+			if width := text.LongestLineLen(value); width > widthValue {
+				widthValue = width
+			}
+		}
+	}
+
+	// make room for the labels in the separators: "-[ <label> ]-"
+	lenSeparator := t.getExpandedRowLength(widthName, 0)
+	if t.style.Options.DrawBorder {
+		lenSeparator -= text.StringWidthWithoutEscSequences(t.style.Box.Left + t.style.Box.Right)
+	}
+	for _, record := range records {
+		lenLabel := text.StringWidthWithoutEscSequences(record.label) + 6
+		if lenLabel-lenSeparator > widthValue {
+			widthValue = lenLabel - lenSeparator
+		}
+	}
+
+	// wrap the values to fit within the maximum allowed row length
+	if widthMax := t.style.Size.WidthMax; widthMax > 0 {
+		if rowLength := t.getExpandedRowLength(widthName, widthValue); rowLength > widthMax {
+			widthValue -= rowLength - widthMax
+			if widthValue < 1 {
+				widthValue = 1
+			}
+		}
+	}
+	return widthName, widthValue
+}
+
+// shouldRenderExpanded returns true if the Table has to be rendered in the
+// expanded mode as directed by Style().Options.Expanded.
+func (t *Table) shouldRenderExpanded() bool {
+	if t.transposed || t.numColumns == 0 {
+		return false
+	}
+	switch t.style.Options.Expanded {
+	case ExpandedOn:
+		return true
+	case ExpandedAuto:
+		return t.style.Size.WidthMax > 0 && t.maxRowLength > t.style.Size.WidthMax
+	default:
+		return false
+	}
+}
@@ -0,0 +1,200 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/tinybit/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTable_Render_Expanded(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendHeader(testHeader)
+		tw.AppendRows(testRows)
+		tw.AppendFooter(testFooter)
+		tw.SetStyle(StyleLight)
+		tw.Style().Options.Expanded = ExpandedOn
+
+		compareOutput(t, tw.Render(), `
+┌─[ RECORD 1 ]─────────────────────────────┐
+│ #          │ 1                           │
+│ FIRST NAME │ Arya                        │
+│ LAST NAME  │ Stark                       │
+│ SALARY     │ 3000                        │
+│ E          │                             │
+├─[ RECORD 2 ]─────────────────────────────┤
+│ #          │ 20                          │
+│ FIRST NAME │ Jon                         │
+│ LAST NAME  │ Snow                        │
+│ SALARY     │ 2000                        │
+│ E          │ You know nothing, Jon Snow! │
+├─[ RECORD 3 ]─────────────────────────────┤
+│ #          │ 300                         │
+│ FIRST NAME │ Tyrion                      │
+│ LAST NAME  │ Lannister                   │
+│ SALARY     │ 5000                        │
+│ E          │                             │
+├─[ FOOTER ]─┼─────────────────────────────┤
+│ #          │                             │
+│ FIRST NAME │                             │
+│ LAST NAME  │ TOTAL                       │
+│ SALARY     │ 10000                       │
+│ E          │                             │
+└────────────┴─────────────────────────────┘`)
+	})
+
+	t.Run("auto", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendHeader(testHeader)
+		tw.AppendRows(testRows[:2])
+		tw.Style().Options.Expanded = ExpandedAuto
+		tw.Style().Size.WidthMax = 80
+
+		compareOutput(t, tw.Render(), `
++----+------------+-----------+--------+-----------------------------+
+|  # | FIRST NAME | LAST NAME | SALARY |                             |
++----+------------+-----------+--------+-----------------------------+
+|  1 | Arya       | Stark     |   3000 |                             |
+| 20 | Jon        | Snow      |   2000 | You know nothing, Jon Snow! |
++----+------------+-----------+--------+-----------------------------+`)
+
+		tw.Style().Size.WidthMax = 40
+		compareOutput(t, tw.Render(), `
++-[ RECORD 1 ]-------------------------+
+| #          | 1                       |
+| FIRST NAME | Arya                    |
+| LAST NAME  | Stark                   |
+| SALARY     | 3000                    |
+| E          |                         |
++-[ RECORD 2 ]-------------------------+
+| #          | 20                      |
+| FIRST NAME | Jon                     |
+| LAST NAME  | Snow                    |
+| SALARY     | 2000                    |
+| E          | You know nothing, Jon   |
+|            | Snow!                   |
++------------+-------------------------+`)
+
+		tw.Style().Options.Expanded = ExpandedOff
+		compareOutput(t, tw.Render(), `
++----+------------+-----------+------- ~
+|  # | FIRST NAME | LAST NAME | SALARY ~
++----+------------+-----------+------- ~
+|  1 | Arya       | Stark     |   3000 ~
+| 20 | Jon        | Snow      |   2000 ~
++----+------------+-----------+------- ~`)
+	})
+
+	t.Run("no border title caption", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendHeader(testHeader)
+		tw.AppendRows(testRows[:2])
+		tw.SetTitle(testTitle1)
+		tw.SetCaption(testCaption)
+		tw.Style().Options.DrawBorder = false
+		tw.Style().Options.Expanded = ExpandedOn
+
+		tw.SuppressTrailingSpaces()
+
+		compareOutput(t, tw.Render(), `
+ Game of Thrones
+-[ RECORD 1 ]-----------------------------
+ #          | 1
+ FIRST NAME | Arya
+ LAST NAME  | Stark
+ SALARY     | 3000
+ E          |
+-[ RECORD 2 ]-----------------------------
+ #          | 20
+ FIRST NAME | Jon
+ LAST NAME  | Snow
+ SALARY     | 2000
+ E          | You know nothing, Jon Snow!
+A Song of Ice and Fire`)
+	})
+
+	t.Run("configs and groups", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendHeader(Row{"Region", "Product", "Notes"})
+		tw.AppendRows([]Row{
+			{"North", "Widget", "first line\nsecond line"},
+			{"South", "Gadget", "a rather long note that has to be wrapped"},
+			{"North", "Gadget", ""},
+		})
+		tw.GroupBy([]GroupBy{{Name: "Region"}})
+		tw.SetColumnConfigs([]ColumnConfig{
+			{Name: "Product", Transformer: func(val interface{}) string {
+				return "<" + val.(string) + ">"
+			}},
+			{Name: "Region", Hidden: true},
+		})
+		tw.Style().Options.Expanded = ExpandedOn
+		tw.Style().Size.WidthMax = 30
+
+		compareOutput(t, tw.Render(), `
++-[ North (2) ]--------------+
++-[ RECORD 1 ]---------------+
+| PRODUCT | <Widget>         |
+| NOTES   | first line       |
+|         | second line      |
++-[ RECORD 2 ]---------------+
+| PRODUCT | <Gadget>         |
+| NOTES   |                  |
++-[ South (1) ]--------------+
++-[ RECORD 3 ]---------------+
+| PRODUCT | <Gadget>         |
+| NOTES   | a rather long    |
+|         | note that has to |
+|         | be wrapped       |
++---------+------------------+`)
+	})
+
+	t.Run("colors", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendHeader(Row{"Name", "Score"})
+		tw.AppendRows([]Row{{"Arya", 3}})
+		tw.SetColumnConfigs([]ColumnConfig{
+			{Name: "Score", Colors: text.Colors{text.FgGreen}},
+		})
+		tw.SetStyle(StyleColoredDark)
+		tw.Style().Options.Expanded = ExpandedOn
+
+		compareOutputColored(t, tw.Render(), ""+
+			"\x1b[96;100m-[ RECORD 1 ]-\x1b[0m\n"+
+			"\x1b[96;100m NAME  \x1b[0m\x1b[97;40m Arya  \x1b[0m\n"+
+			"\x1b[96;100m SCORE \x1b[0m\x1b[32m 3     \x1b[0m")
+	})
+
+	t.Run("pager", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendHeader(testHeader)
+		tw.AppendRows(testRows)
+		tw.Style().Options.Expanded = ExpandedOn
+
+		pager := tw.Pager(PageSize(2))
+		compareOutput(t, pager.Render(), `
++-[ RECORD 1 ]-----------------------------+
+| #          | 1                           |
+| FIRST NAME | Arya                        |
+| LAST NAME  | Stark                       |
+| SALARY     | 3000                        |
+| E          |                             |
++-[ RECORD 2 ]-----------------------------+
+| #          | 20                          |
+| FIRST NAME | Jon                         |
+| LAST NAME  | Snow                        |
+| SALARY     | 2000                        |
+| E          | You know nothing, Jon Snow! |
++------------+-----------------------------+`)
+		compareOutput(t, pager.Next(), `
++-[ RECORD 3 ]-----------------------------+
+| #          | 300                         |
+| FIRST NAME | Tyrion                      |
+| LAST NAME  | Lannister                   |
+| SALARY     | 5000                        |
+| E          |                             |
++------------+-----------------------------+`)
+		assert.Equal(t, 2, pager.Location())
+	})
+}
@@ -43,8 +43,13 @@ type renderMode string
 
 const (
 	renderModeDefault  renderMode = "default"
+	renderModeAsciiDoc renderMode = "asciidoc"
 	renderModeCSV      renderMode = "csv"
 	renderModeMarkdown renderMode = "markdown"
 	renderModeTSV      renderMode = "tsv"
 	renderModeHTML     renderMode = "html"
+	renderModeJSON     renderMode = "json"
+	renderModeLaTeX    renderMode = "latex"
+	renderModeNDJSON   renderMode = "ndjson"
+	renderModeRST      renderMode = "rst"
 )
@@ -119,12 +119,32 @@ func (t *Table) htmlRenderColumn(out *strings.Builder, colStr string) {
 	out.WriteString(colStr)
 }
 
+func (t *Table) htmlRenderColumnNested(out *strings.Builder, nested renderableHTML) {
+	out.WriteRune('\n')
+	for _, line := range strings.Split(nested.RenderHTML(), "\n") {
+		out.WriteString("      ")
+		out.WriteString(line)
+		out.WriteRune('\n')
+	}
+	out.WriteString("    ")
+}
+
 func (t *Table) htmlRenderColumnAttributes(out *strings.Builder, colIdx int, hint renderHint, alignOverride text.Align) {
 	// determine the HTML "align"/"valign" property values
 	align := alignOverride.HTMLProperty()
 	vAlign := t.getVAlign(colIdx, hint).HTMLProperty()
-	// determine the HTML "class" property values for the colors
-	class := t.getColumnColors(colIdx, hint).HTMLProperty()
+	// determine the HTML "class" property values for the colors, and the
+	// "style" property values for the 256-colors without CSS classes defined
+	var classColors text.Colors
+	var styles []string
+	for _, color := range t.getColumnColors(colIdx, hint) {
+		if style := color.CSSStyle(); style != "" {
+			styles = append(styles, style)
+		} else {
+			classColors = append(classColors, color)
+		}
+	}
+	class := classColors.HTMLProperty()
 
 	if align != "" {
 		out.WriteRune(' ')
@@ -134,6 +154,11 @@ func (t *Table) htmlRenderColumnAttributes(out *strings.Builder, colIdx int, hin
 		out.WriteRune(' ')
 		out.WriteString(class)
 	}
+	if len(styles) > 0 {
+		out.WriteString(" style=\"")
+		out.WriteString(strings.Join(styles, ";"))
+		out.WriteRune('"')
+	}
 	if vAlign != "" {
 		out.WriteRune(' ')
 		out.WriteString(vAlign)
@@ -157,7 +182,15 @@ func (t *Table) htmlRenderColumnAutoIndex(out *strings.Builder, hint renderHint)
 }
 
 func (t *Table) htmlRenderRow(out *strings.Builder, row rowStr, hint renderHint) {
-	out.WriteString("  <tr>\n")
+	if groupRow := t.getGroupRow(hint.rowNumber - 1); hint.isRegularRow() && groupRow.groupBy != nil {
+		if groupRow.isSubtotal {
+			out.WriteString("  <tr class=\"group-subtotal\">\n")
+		} else {
+			out.WriteString("  <tr class=\"group-header\">\n")
+		}
+	} else {
+		out.WriteString("  <tr>\n")
+	}
 	for colIdx := 0; colIdx < t.numColumns; colIdx++ {
 		// auto-index column
 		if colIdx == 0 && t.autoIndex {
@@ -171,7 +204,9 @@ func (t *Table) htmlRenderRow(out *strings.Builder, row rowStr, hint renderHint)
 		align := t.getAlign(colIdx, hint)
 		rowConfig := t.getRowConfig(hint)
 		extraColumnsRendered := 0
-		if rowConfig.AutoMerge && !hint.isSeparatorRow {
+		if numColumns := t.getCellColSpan(colIdx, hint); numColumns > 1 {
+			extraColumnsRendered = numColumns - 1
+		} else if rowConfig.AutoMerge && !hint.isSeparatorRow {
 			// get the real row to consider all lines in each column instead of just
 			// looking at the current "line"
 			rowUnwrapped := t.getRow(hint.rowNumber-1, hint)
@@ -189,15 +224,23 @@ func (t *Table) htmlRenderRow(out *strings.Builder, row rowStr, hint renderHint)
 		out.WriteString("    <")
 		out.WriteString(colTagName)
 		t.htmlRenderColumnAttributes(out, colIdx, hint, align)
+		rowSpan := t.shouldMergeCellsVerticallyBelow(colIdx, hint)
 		if extraColumnsRendered > 0 {
 			out.WriteString(" colspan=")
 			out.WriteString(fmt.Sprint(extraColumnsRendered + 1))
-		} else if rowSpan := t.shouldMergeCellsVerticallyBelow(colIdx, hint); rowSpan > 1 {
+			// only Cells can span multiple columns and rows at the same time
+			if t.getCellSpans(hint).get(hint.rowNumber-1, colIdx) == nil {
+				rowSpan = 0
+			}
+		}
+		if rowSpan > 1 {
 			out.WriteString(" rowspan=")
 			out.WriteString(fmt.Sprint(rowSpan))
 		}
 		out.WriteString(">")
-		if len(colStr) == 0 {
+		if nested, ok := t.getRawValue(colIdx, hint).(renderableHTML); ok && t.getColumnTransformer(colIdx, hint) == nil {
+			t.htmlRenderColumnNested(out, nested)
+		} else if len(colStr) == 0 {
 			out.WriteString(t.style.HTML.EmptyColumn)
 		} else {
 			t.htmlRenderColumn(out, colStr)
@@ -229,6 +272,9 @@ func (t *Table) htmlRenderRows(out *strings.Builder, rows []rowStr, hint renderH
 					out.WriteString(rowsTag)
 					out.WriteString(">\n")
 					renderedTagOpen = true
+				} else if hint.isRegularRow() && t.getGroupRow(idx-1).isGroupEnd {
+					// every group gets a tbody of its own
+					out.WriteString("  </tbody>\n  <tbody>\n")
 				}
 				t.htmlRenderRow(out, row, hint)
 				shouldRenderTagClose = true
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/tinybit/go-pretty/v6/list"
 	"github.com/tinybit/go-pretty/v6/text"
 	"github.com/stretchr/testify/assert"
 )
@@ -682,3 +683,69 @@ func TestTable_RenderHTML_Sorted(t *testing.T) {
   </tfoot>
 </table>`)
 }
+
+func TestTable_RenderHTML_Nested(t *testing.T) {
+	twInner := NewWriter()
+	twInner.AppendHeader(Row{"First Name", "Last Name"})
+	twInner.AppendRow(Row{"Arya", "Stark"})
+	twInner.AppendRow(Row{"Jon", "Snow <Stark>"})
+
+	lw := list.NewWriter()
+	lw.AppendItems([]interface{}{"Winter", "Is", "Coming"})
+
+	tw := NewWriter()
+	tw.AppendHeader(Row{"House", "Members", "Words"})
+	tw.AppendRow(Row{"Stark", twInner, lw})
+
+	compareOutput(t, tw.RenderHTML(), `
+<table class="go-pretty-table">
+  <thead>
+  <tr>
+    <th>House</th>
+    <th>Members</th>
+    <th>Words</th>
+  </tr>
+  </thead>
+  <tbody>
+  <tr>
+    <td>Stark</td>
+    <td>
+      <table class="go-pretty-table">
+        <thead>
+        <tr>
+          <th>First Name</th>
+          <th>Last Name</th>
+        </tr>
+        </thead>
+        <tbody>
+        <tr>
+          <td>Arya</td>
+          <td>Stark</td>
+        </tr>
+        <tr>
+          <td>Jon</td>
+          <td>Snow &lt;Stark&gt;</td>
+        </tr>
+        </tbody>
+      </table>
+    </td>
+    <td>
+      <ul class="go-pretty-table">
+        <li>Winter</li>
+        <li>Is</li>
+        <li>Coming</li>
+      </ul>
+    </td>
+  </tr>
+  </tbody>
+</table>`)
+
+	t.Run("with transformer", func(t *testing.T) {
+		tw.SetColumnConfigs([]ColumnConfig{{
+			Number:      3,
+			Transformer: func(val interface{}) string { return "<words>" },
+		}})
+
+		assert.Contains(t, tw.RenderHTML(), "<td>&lt;words&gt;</td>")
+	})
+}
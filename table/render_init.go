@@ -9,7 +9,7 @@ import (
 	"github.com/tinybit/go-pretty/v6/text"
 )
 
-func (t *Table) analyzeAndStringify(row Row, hint renderHint) rowStr {
+func (t *Table) analyzeAndStringify(row Row, spans rowCellSpans, hint renderHint) rowStr {
 	// update t.numColumns if this row is the longest seen till now
 	if len(row) > t.numColumns {
 		// init the slice for the first time; and pad it the rest of the time
@@ -25,6 +25,11 @@ func (t *Table) analyzeAndStringify(row Row, hint renderHint) rowStr {
 	// convert each column to string and figure out if it has non-numeric data
 	rowOut := make(rowStr, len(row))
 	for colIdx, col := range row {
+		// cells covered by a Cell spanning multiple columns/rows are empty
+		if spans.isCovered(colIdx) {
+			continue
+		}
+
 		// if the column is not a number, keep track of it
 		if !hint.isHeaderRow && !hint.isFooterRow && !t.columnIsNonNumeric[colIdx] && !isNumber(col) {
 			t.columnIsNonNumeric[colIdx] = true
@@ -42,6 +47,8 @@ func (t *Table) analyzeAndStringifyColumn(colIdx int, col interface{}, hint rend
 		colStr = transformer(col)
 	} else if colStrVal, ok := col.(string); ok {
 		colStr = colStrVal
+	} else if renderable, ok := col.(Renderable); ok {
+		colStr = t.renderNested(colIdx, renderable)
 	} else {
 		colStr = convertValueToString(col)
 	}
@@ -54,6 +61,20 @@ func (t *Table) analyzeAndStringifyColumn(colIdx int, col interface{}, hint rend
 	return t.directionModifier + colStr
 }
 
+// renderNested renders the given Renderable value, with nested tables being
+// restricted to the maximum width of the column (if any) in the default mode.
+func (t *Table) renderNested(colIdx int, renderable Renderable) string {
+	if nestedTable, ok := renderable.(Writer); ok && t.renderMode == renderModeDefault {
+		size := &nestedTable.Style().Size
+		if widthMax := t.getColumnWidthMax(colIdx); widthMax > 0 && (size.WidthMax == 0 || size.WidthMax > widthMax) {
+			widthMaxOrig := size.WidthMax
+			size.WidthMax = widthMax
+			defer func() { size.WidthMax = widthMaxOrig }()
+		}
+	}
+	return renderable.Render()
+}
+
 func (t *Table) extractMaxColumnLengths(rows []rowStr, hint renderHint) {
 	for rowIdx, row := range rows {
 		hint.rowNumber = rowIdx + 1
@@ -165,7 +186,7 @@ func (t *Table) initForRender(mode renderMode) {
 	t.renderMode = mode
 
 	// pick a default style if none was set until now
-	t.Style()
+	t.getStyle()
 
 	// reset rendering state
 	t.reset()
@@ -182,13 +203,17 @@ func (t *Table) initForRender(mode renderMode) {
 	// find the longest continuous line in each column
 	t.initForRenderColumnLengths()
 	t.initForRenderMaxRowLength()
+
+	// shrink and/or hide columns to fit within the maximum row length
+	t.initForRenderFitColumns()
 	t.initForRenderPaddedColumns()
 
 	// generate a separator row and calculate maximum row length
 	t.initForRenderRowSeparator()
 
-	// reset the counter for the number of lines rendered
+	// reset the counter for the number of lines rendered, and the page breaks
 	t.numLinesRendered = 0
+	t.pager.pageBreaks = nil
 }
 
 func (t *Table) initForRenderColumnConfigs() {
@@ -233,6 +258,14 @@ func (t *Table) initForRenderHideColumns() {
 	}
 	colIdxMap := t.hideColumns()
 
+	// keep track of the raw column behind each visible column; the columns
+	// might have been hidden already once before
+	visibleColumns := make([]int, t.numColumns)
+	for oldColIdx, newColIdx := range colIdxMap {
+		visibleColumns[newColIdx] = t.getRawColumnIndex(oldColIdx)
+	}
+	t.visibleColumns = visibleColumns
+
 	// re-create columnIsNonNumeric with new column indices
 	columnIsNonNumeric := make([]bool, t.numColumns)
 	for oldColIdx, nonNumeric := range t.columnIsNonNumeric {
@@ -305,21 +338,121 @@ func (t *Table) initForRenderRows() {
 
 	// stringify the filtered rows
 	t.numColumns = 0
-	t.rows = t.initForRenderRowsStringify(t.rowsRawFiltered, renderHint{})
-	t.rowsFooter = t.initForRenderRowsStringify(t.rowsFooterRaw, renderHint{isFooterRow: true})
-	t.rowsHeader = t.initForRenderRowsStringify(t.rowsHeaderRaw, renderHint{isHeaderRow: true})
+	t.rows = t.initForRenderRowsStringify(t.rowsRawFiltered, t.getFilteredRowCellSpans, renderHint{})
+	t.rowsFooter = t.initForRenderRowsStringify(t.rowsFooterRaw,
+		func(rowIdx int) rowCellSpans { return t.rowsFooterCellSpans[rowIdx] }, renderHint{isFooterRow: true})
+	t.rowsHeader = t.initForRenderRowsStringify(t.rowsHeaderRaw,
+		func(rowIdx int) rowCellSpans { return t.rowsHeaderCellSpans[rowIdx] }, renderHint{isHeaderRow: true})
+
+	// compute the footer aggregates from the filtered rows
+	t.initForRenderFooterAggregates()
 
 	// sort the rows as requested
 	t.initForRenderSortRows()
 
+	// group the rows as requested
+	t.initForRenderGroupRows()
+
 	// find the row colors (if any)
 	t.initForRenderRowPainterColors()
+	t.initForRenderCellColors()
 
 	// suppress columns without any content
 	t.initForRenderSuppressColumns()
 
+	// hide the columns not in the pane being rendered (if any)
+	t.initForRenderPaneColumns()
+
 	// strip out hidden columns
 	t.initForRenderHideColumns()
+
+	// swap the rows and columns as requested
+	t.initForRenderTranspose()
+
+	// map out the cells spanning multiple columns/rows
+	t.initForRenderCellSpans()
+}
+
+// initForRenderCellSpans maps out the cells in each section of the Table that
+// are a part of a Cell spanning multiple columns and/or rows.
+func (t *Table) initForRenderCellSpans() {
+	if t.transposed {
+		return
+	}
+	getCellSpans := func(numRows int, getRowCellSpans func(rowIdx int) rowCellSpans) cellSpans {
+		var spans cellSpans
+		for rowIdx := 0; rowIdx < numRows; rowIdx++ {
+			rowSpans := getRowCellSpans(rowIdx)
+			for colIdx := 0; colIdx < t.numColumns && rowSpans != nil; colIdx++ {
+				var cell *Cell
+				if span, ok := rowSpans[t.getRawColumnIndex(colIdx)]; !ok {
+					// not a part of any Cell
+				} else if !span.isCovered {
+					cell = span.origin
+				} else if spans.get(rowIdx, colIdx-1) == span.origin || spans.get(rowIdx-1, colIdx) == span.origin {
+					// covered cells are merged only if they are still next to
+					// the Cell (or another cell covered by it) after filtering
+					// and sorting
+					cell = span.origin
+				}
+				if cell != nil {
+					if spans == nil {
+						spans = make(cellSpans, numRows)
+					}
+					if spans[rowIdx] == nil {
+						spans[rowIdx] = make([]*Cell, t.numColumns)
+					}
+					spans[rowIdx][colIdx] = cell
+				}
+			}
+		}
+		return spans
+	}
+
+	t.cellSpans = getCellSpans(len(t.rows), t.getRawRowCellSpans)
+	t.cellSpansFooter = getCellSpans(len(t.rowsFooterRaw), func(rowIdx int) rowCellSpans { return t.rowsFooterCellSpans[rowIdx] })
+	t.cellSpansHeader = getCellSpans(len(t.rowsHeader), func(rowIdx int) rowCellSpans { return t.rowsHeaderCellSpans[rowIdx] })
+}
+
+// initForRenderFooterAggregates renders the values computed using
+// ColumnConfig.Aggregate in the last Footer row.
+func (t *Table) initForRenderFooterAggregates() {
+	hasAggregates := false
+	for _, cfg := range t.columnConfigMap {
+		if cfg.Aggregate != nil {
+			hasAggregates = true
+			break
+		}
+	}
+	if !hasAggregates {
+		return
+	}
+
+	if len(t.rowsFooter) == 0 {
+		t.rowsFooter = append(t.rowsFooter, rowStr{})
+	}
+	hint := renderHint{isFooterRow: true, rowNumber: len(t.rowsFooter)}
+	row := t.rowsFooter[len(t.rowsFooter)-1]
+	for colIdx, cfg := range t.columnConfigMap {
+		if cfg.Aggregate == nil || colIdx >= t.numColumns {
+			continue
+		}
+		for len(row) <= colIdx {
+			row = append(row, "")
+		}
+
+		values := make([]interface{}, 0, len(t.rowsRawFiltered))
+		for rowIdx, rowRaw := range t.rowsRawFiltered {
+			if colIdx < len(rowRaw) && !t.getFilteredRowCellSpans(rowIdx).isCovered(colIdx) {
+				values = append(values, rowRaw[colIdx])
+			}
+		}
+		row[colIdx] = ""
+		if value := cfg.Aggregate(values); value != nil {
+			row[colIdx] = t.analyzeAndStringifyColumn(colIdx, value, hint)
+		}
+	}
+	t.rowsFooter[len(t.rowsFooter)-1] = row
 }
 
 // initForRenderFilterRows filters the raw rows by removing non-matching rows from t.rowsRawFiltered.
@@ -333,8 +466,15 @@ func (t *Table) initForRenderFilterRows() {
 			t.rowsRawFiltered[i] = rowCopy
 		}
 	}
+	t.rowsRawFilteredCellSpans = nil
+	if t.rowsCellSpans != nil {
+		t.rowsRawFilteredCellSpans = make([]rowCellSpans, len(t.rowsRawFiltered))
+		for rowIdx := range t.rowsRawFilteredCellSpans {
+			t.rowsRawFilteredCellSpans[rowIdx] = t.rowsCellSpans[rowIdx]
+		}
+	}
 
-	if len(t.filterBy) == 0 {
+	if len(t.filterBy) == 0 && t.filter == nil {
 		// No filters, nothing to do
 		return
 	}
@@ -348,7 +488,8 @@ func (t *Table) initForRenderFilterRows() {
 	// Calculate numColumns from raw rows/headers for filter parsing
 	t.calculateNumColumnsFromRaw()
 	parsedFilterBy := t.parseFilterBy(t.filterBy)
-	if len(parsedFilterBy) == 0 {
+	parsedFilter := t.parseFilter(t.filter)
+	if len(parsedFilterBy) == 0 && parsedFilter == nil {
 		// No valid filters, nothing to do
 		return
 	}
@@ -357,12 +498,19 @@ func (t *Table) initForRenderFilterRows() {
 	filteredRows := t.rowsRawFiltered[:0]
 	keptIndices := make([]int, 0, len(t.rowsRawFiltered))
 	for origIdx, row := range t.rowsRawFiltered {
-		if t.matchesFiltersRaw(row, parsedFilterBy) {
+		if t.matchesFiltersRaw(row, parsedFilterBy) && (parsedFilter == nil || parsedFilter.matchesRaw(t, row)) {
 			filteredRows = append(filteredRows, row)
 			keptIndices = append(keptIndices, origIdx)
 		}
 	}
 	t.rowsRawFiltered = filteredRows
+	if t.rowsRawFilteredCellSpans != nil {
+		filteredCellSpans := make([]rowCellSpans, len(keptIndices))
+		for newIdx, origIdx := range keptIndices {
+			filteredCellSpans[newIdx] = t.rowsRawFilteredCellSpans[origIdx]
+		}
+		t.rowsRawFilteredCellSpans = filteredCellSpans
+	}
 
 	// Update separators map to reflect filtered rows
 	if len(originalSeparators) > 0 {
@@ -376,11 +524,11 @@ func (t *Table) initForRenderFilterRows() {
 	}
 }
 
-func (t *Table) initForRenderRowsStringify(rows []Row, hint renderHint) []rowStr {
+func (t *Table) initForRenderRowsStringify(rows []Row, getRowCellSpans func(rowIdx int) rowCellSpans, hint renderHint) []rowStr {
 	rowsStr := make([]rowStr, len(rows))
 	for idx, row := range rows {
 		hint.rowNumber = idx + 1
-		rowsStr[idx] = t.analyzeAndStringify(row, hint)
+		rowsStr[idx] = t.analyzeAndStringify(row, getRowCellSpans(idx), hint)
 	}
 	return rowsStr
 }
@@ -397,6 +545,9 @@ func (t *Table) initForRenderRowPainterColors() {
 	// For each final position, find the row index in t.rowsRawFiltered (which is already filtered)
 	for finalPos := range t.rows {
 		var rowIdx int
+		if t.isGroupRow(finalPos) {
+			continue
+		}
 
 		if len(t.sortedRowIndices) > 0 {
 			// Rows were sorted: finalPos -> sortedRowIndices[finalPos] -> rowIdx in t.rowsRawFiltered
@@ -522,17 +673,31 @@ func (t *Table) initForRenderSuppressColumns() {
 // that are written to in this file
 func (t *Table) reset() {
 	t.autoIndexVIndexMaxLength = 0
+	t.cellSpans = nil
+	t.cellSpansFooter = nil
+	t.cellSpansHeader = nil
 	t.columnConfigMap = nil
 	t.columnIsNonNumeric = nil
 	t.firstRowOfPage = true
+	t.groupRows = nil
+	t.groupRowsCellSpans = nil
+	t.groupRowsRaw = nil
 	t.maxColumnLengths = nil
 	t.maxRowLength = 0
 	t.numColumns = 0
+	t.numColumnsHiddenToFit = 0
 	t.numLinesRendered = 0
 	t.rowSeparators = nil
 	t.rows = nil
+	t.rowsCellColors = nil
 	t.rowsColors = nil
 	t.rowsFooter = nil
 	t.rowsHeader = nil
+	t.rowsRawTransposed = nil
 	t.sortedRowIndices = nil
+	t.transposed = false
+	t.transposedColumnConfigs = nil
+	t.transposedFooterColumns = 0
+	t.transposedHeaderColumns = 0
+	t.visibleColumns = nil
 }
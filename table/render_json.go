@@ -0,0 +1,152 @@
+package table
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// RenderJSON renders the Table as a JSON array of objects, one per row, keyed
+// by the names of the columns in the first Header row. Example:
+//
+//	[
+//	  {"#":1,"First Name":"Arya","Last Name":"Stark","Salary":3000,"E":null},
+//	  {"#":20,"First Name":"Jon","Last Name":"Snow","Salary":2000,"E":"You know nothing, Jon Snow!"},
+//	  {"#":300,"First Name":"Tyrion","Last Name":"Lannister","Salary":5000,"E":null}
+//	]
+//
+// The rows are filtered, sorted and stripped of hidden columns just like in
+// the other render modes, but the values are rendered in their raw form (not
+// using the Transformers) so that numbers, booleans and nils retain their
+// type. Columns without a name in the Header get keys like "A", "B", etc.
+// Title, Caption, Footer rows and the group header/subtotal rows inserted by
+// GroupBy are not rendered.
+func (t *Table) RenderJSON() string {
+	t.initForRender(renderModeJSON)
+
+	var out strings.Builder
+	out.WriteString("[")
+	if t.numColumns > 0 {
+		keys := t.jsonKeys()
+		numRowsRendered := 0
+		for rowIdx := range t.rows {
+			if t.isGroupRow(rowIdx) {
+				continue
+			}
+			if numRowsRendered > 0 {
+				out.WriteRune(',')
+			}
+			out.WriteString("\n  ")
+			t.jsonRenderRow(&out, keys, rowIdx)
+			numRowsRendered++
+		}
+		if numRowsRendered > 0 {
+			out.WriteRune('\n')
+		}
+	}
+	out.WriteString("]")
+	return t.render(&out)
+}
+
+// RenderNDJSON renders the Table as newline-delimited JSON with one object per
+// row. Refer to RenderJSON for the details on how the objects are generated.
+// Example:
+//
+//	{"#":1,"First Name":"Arya","Last Name":"Stark","Salary":3000,"E":null}
+//	{"#":20,"First Name":"Jon","Last Name":"Snow","Salary":2000,"E":"You know nothing, Jon Snow!"}
+//	{"#":300,"First Name":"Tyrion","Last Name":"Lannister","Salary":5000,"E":null}
+func (t *Table) RenderNDJSON() string {
+	t.initForRender(renderModeNDJSON)
+
+	var out strings.Builder
+	if t.numColumns > 0 {
+		keys := t.jsonKeys()
+		numRowsRendered := 0
+		for rowIdx := range t.rows {
+			if t.isGroupRow(rowIdx) {
+				continue
+			}
+			if numRowsRendered > 0 {
+				out.WriteRune('\n')
+			}
+			t.jsonRenderRow(&out, keys, rowIdx)
+			numRowsRendered++
+		}
+	}
+	return t.render(&out)
+}
+
+// getRawColumnIndex returns the index of the column in the raw rows for the
+// given index of a column being rendered.
+func (t *Table) getRawColumnIndex(colIdx int) int {
+	if t.visibleColumns != nil && colIdx < len(t.visibleColumns) {
+		return t.visibleColumns[colIdx]
+	}
+	return colIdx
+}
+
+// getRawRow returns the raw row for the given index of a row being rendered
+// (after filtering and sorting).
+func (t *Table) getRawRow(rowIdx int) Row {
+	if t.transposed {
+		if rowIdx >= 0 && rowIdx < len(t.rowsRawTransposed) {
+			return t.rowsRawTransposed[rowIdx]
+		}
+		return nil
+	}
+	if len(t.sortedRowIndices) > 0 {
+		rowIdx = t.sortedRowIndices[rowIdx]
+	}
+	if rowIdx >= 0 && rowIdx < len(t.rowsRawFiltered) {
+		return t.rowsRawFiltered[rowIdx]
+	}
+	if rowIdx -= len(t.rowsRawFiltered); rowIdx >= 0 && rowIdx < len(t.groupRowsRaw) {
+		return t.groupRowsRaw[rowIdx]
+	}
+	return nil
+}
+
+func (t *Table) jsonKeys() []string {
+	keys := make([]string, t.numColumns)
+	for colIdx := range keys {
+		rawColIdx := t.getRawColumnIndex(colIdx)
+		if len(t.rowsHeaderRaw) > 0 && rawColIdx < len(t.rowsHeaderRaw[0]) {
+			keys[colIdx] = convertValueToString(t.rowsHeaderRaw[0][rawColIdx])
+		}
+		if keys[colIdx] == "" {
+			keys[colIdx] = AutoIndexColumnID(rawColIdx)
+		}
+	}
+	return keys
+}
+
+func (t *Table) jsonRenderRow(out *strings.Builder, keys []string, rowIdx int) {
+	row := t.getRawRow(rowIdx)
+	out.WriteRune('{')
+	for colIdx, key := range keys {
+		if colIdx > 0 {
+			out.WriteRune(',')
+		}
+		t.jsonRenderValue(out, key)
+		out.WriteRune(':')
+
+		var val interface{}
+		if rawColIdx := t.getRawColumnIndex(colIdx); rawColIdx < len(row) {
+			val = row[rawColIdx]
+		}
+		t.jsonRenderValue(out, val)
+	}
+	out.WriteRune('}')
+}
+
+func (t *Table) jsonRenderValue(out *strings.Builder, val interface{}) {
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(val); err != nil {
+		// values that cannot be marshaled are rendered in their string form
+		b.Reset()
+		_ = enc.Encode(convertValueToString(val))
+	}
+	out.Write(bytes.TrimRight(b.Bytes(), "\n"))
+}
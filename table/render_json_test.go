@@ -0,0 +1,61 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tinybit/go-pretty/v6/text"
+)
+
+func TestTable_RenderJSON(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(testHeader)
+	tw.AppendRows(testRows)
+	tw.AppendRow(Row{400, "Sansa", "Stark", nil, true})
+	tw.AppendFooter(testFooter)
+	tw.SetCaption(testCaption)
+	tw.SetColumnConfigs([]ColumnConfig{
+		{Name: "Salary", Transformer: text.NewNumberTransformer("%05d")},
+	})
+	tw.SetTitle(testTitle1)
+
+	compareOutput(t, tw.RenderJSON(), `
+[
+  {"#":1,"First Name":"Arya","Last Name":"Stark","Salary":3000,"E":null},
+  {"#":20,"First Name":"Jon","Last Name":"Snow","Salary":2000,"E":"You know nothing, Jon Snow!"},
+  {"#":300,"First Name":"Tyrion","Last Name":"Lannister","Salary":5000,"E":null},
+  {"#":400,"First Name":"Sansa","Last Name":"Stark","Salary":null,"E":true}
+]`)
+}
+
+func TestTable_RenderJSON_Empty(t *testing.T) {
+	tw := NewWriter()
+	assert.Equal(t, "[]", tw.RenderJSON())
+	assert.Empty(t, tw.RenderNDJSON())
+}
+
+func TestTable_RenderJSON_FilterSortHide(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(testHeader)
+	tw.AppendRows(testRows)
+	tw.AppendRow(Row{400, "Sansa", "Stark", 1000, "<b>&</b>"})
+	tw.FilterBy([]FilterBy{{Name: "Salary", Operator: LessThan, Value: 4000}})
+	tw.SortBy([]SortBy{{Name: "Salary", Mode: AscNumeric}})
+	tw.SetColumnConfigs([]ColumnConfig{{Name: "#", Hidden: true}})
+
+	compareOutput(t, tw.RenderJSON(), `
+[
+  {"First Name":"Sansa","Last Name":"Stark","Salary":1000,"E":"<b>&</b>"},
+  {"First Name":"Jon","Last Name":"Snow","Salary":2000,"E":"You know nothing, Jon Snow!"},
+  {"First Name":"Arya","Last Name":"Stark","Salary":3000,"E":null}
+]`)
+
+	tw.SuppressEmptyColumns()
+	tw.FilterBy([]FilterBy{{Name: "Last Name", Operator: Equal, Value: "Stark"}})
+	tw.AppendRow(Row{500, "Bran", "Stark", 0, complex(1, 2)})
+	tw.SetColumnConfigs(nil)
+	compareOutput(t, tw.RenderNDJSON(), `
+{"#":500,"First Name":"Bran","Last Name":"Stark","Salary":0,"E":"(1+2i)"}
+{"#":400,"First Name":"Sansa","Last Name":"Stark","Salary":1000,"E":"<b>&</b>"}
+{"#":1,"First Name":"Arya","Last Name":"Stark","Salary":3000,"E":null}`)
+}
@@ -0,0 +1,207 @@
+package table
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tinybit/go-pretty/v6/text"
+)
+
+var latexReplacer = strings.NewReplacer(
+	`\`, `\textbackslash{}`,
+	`&`, `\&`,
+	`%`, `\%`,
+	`$`, `\$`,
+	`#`, `\#`,
+	`_`, `\_`,
+	`{`, `\{`,
+	`}`, `\}`,
+	`~`, `\textasciitilde{}`,
+	`^`, `\textasciicircum{}`,
+)
+
+// RenderLaTeX renders the Table as a LaTeX "tabular" (or "longtable" if
+// Style().LaTeX.LongTable is set) environment with "booktabs" rules. Example:
+//
+//	\begin{table}
+//	\centering
+//	\caption{Game of Thrones}
+//	\begin{tabular}{rllrl}
+//	\toprule
+//	\# & First Name & Last Name & Salary &  \\
+//	\midrule
+//	1 & Arya & Stark & 3000 &  \\
+//	20 & Jon & Snow & 2000 & You know nothing, Jon Snow! \\
+//	300 & Tyrion & Lannister & 5000 &  \\
+//	\midrule
+//	 &  & Total & 10000 &  \\
+//	\bottomrule
+//	\end{tabular}
+//	\caption*{A Song of Ice and Fire}
+//	\end{table}
+//
+// The Title and Caption are rendered using \caption (the latter using
+// \caption* which needs the "caption" package when in a "table" float), and
+// the "tabular" is wrapped in a "table" float only if either is set. Cells
+// merged horizontally using RowConfig.AutoMerge or Cell.ColSpan are rendered
+// with \multicolumn, and multi-line cells as nested "tabular" environments.
+// ANSI escape sequences are stripped and LaTeX special characters escaped.
+func (t *Table) RenderLaTeX() string {
+	t.initForRender(renderModeLaTeX)
+
+	var out strings.Builder
+	if t.numColumns > 0 {
+		isFloat := !t.style.LaTeX.LongTable && (t.title != "" || t.caption != "")
+		t.latexRenderBegin(&out, isFloat)
+		out.WriteString("\\toprule\n")
+		if t.latexRenderRowsHeader(&out) {
+			out.WriteString("\\midrule\n")
+			if t.style.LaTeX.LongTable {
+				out.WriteString("\\endhead\n")
+			}
+		}
+		t.latexRenderRows(&out, t.rows, renderHint{})
+		if len(t.rowsFooter) > 0 {
+			out.WriteString("\\midrule\n")
+			t.latexRenderRows(&out, t.rowsFooter, renderHint{isFooterRow: true})
+		}
+		out.WriteString("\\bottomrule\n")
+		t.latexRenderEnd(&out, isFloat)
+	}
+	return t.render(&out)
+}
+
+func (t *Table) latexColumnSpec(align text.Align) string {
+	switch align {
+	case text.AlignCenter:
+		return "c"
+	case text.AlignRight:
+		return "r"
+	default:
+		return "l"
+	}
+}
+
+func (t *Table) latexEscape(str string) string {
+	return latexReplacer.Replace(text.StripEscape(str))
+}
+
+func (t *Table) latexRenderBegin(out *strings.Builder, isFloat bool) {
+	if isFloat {
+		out.WriteString("\\begin{table}")
+		if t.style.LaTeX.Placement != "" {
+			out.WriteString("[" + t.style.LaTeX.Placement + "]")
+		}
+		out.WriteString("\n\\centering\n")
+		if t.title != "" {
+			out.WriteString("\\caption{" + t.latexEscape(t.title) + "}\n")
+		}
+	}
+
+	env := "tabular"
+	if t.style.LaTeX.LongTable {
+		env = "longtable"
+	}
+	out.WriteString("\\begin{" + env + "}{")
+	if t.autoIndex {
+		out.WriteRune('r')
+	}
+	for colIdx := 0; colIdx < t.numColumns; colIdx++ {
+		out.WriteString(t.latexColumnSpec(t.getAlign(colIdx, renderHint{})))
+	}
+	out.WriteString("}\n")
+
+	if t.style.LaTeX.LongTable && t.title != "" {
+		out.WriteString("\\caption{" + t.latexEscape(t.title) + "} \\\\\n")
+	}
+}
+
+func (t *Table) latexRenderCell(out *strings.Builder, colStr string, align text.Align, numColumns int) {
+	lines := strings.Split(colStr, "\n")
+	for idx, line := range lines {
+		lines[idx] = t.latexEscape(line)
+	}
+	colStr = strings.Join(lines, ` \\ `)
+	if len(lines) > 1 {
+		colStr = fmt.Sprintf("\\begin{tabular}[c]{@{}%s@{}}%s\\end{tabular}", t.latexColumnSpec(align), colStr)
+	}
+	if numColumns > 1 {
+		colStr = fmt.Sprintf("\\multicolumn{%d}{%s}{%s}", numColumns, t.latexColumnSpec(align), colStr)
+	}
+	out.WriteString(colStr)
+}
+
+func (t *Table) latexRenderEnd(out *strings.Builder, isFloat bool) {
+	if t.style.LaTeX.LongTable {
+		if t.caption != "" {
+			out.WriteString("\\caption*{" + t.latexEscape(t.caption) + "} \\\\\n")
+		}
+		out.WriteString("\\end{longtable}")
+		return
+	}
+
+	out.WriteString("\\end{tabular}")
+	if isFloat {
+		if t.caption != "" {
+			out.WriteString("\n\\caption*{" + t.latexEscape(t.caption) + "}")
+		}
+		out.WriteString("\n\\end{table}")
+	}
+}
+
+func (t *Table) latexRenderRow(out *strings.Builder, row rowStr, hint renderHint) {
+	if t.autoIndex {
+		if hint.isRegularRow() {
+			out.WriteString(fmt.Sprint(hint.rowNumber))
+		}
+		out.WriteString(" & ")
+	}
+
+	rowConfig := t.getRowConfig(hint)
+	for colIdx := 0; colIdx < t.numColumns; colIdx++ {
+		if colIdx > 0 {
+			out.WriteString(" & ")
+		}
+		var colStr string
+		if colIdx < len(row) {
+			colStr = row[colIdx]
+		}
+
+		// look ahead and merge all the cells with the same content
+		align, numColumns := t.getAlign(colIdx, hint), t.getCellColSpan(colIdx, hint)
+		if numColumns == 1 && rowConfig.AutoMerge {
+			for row.areEqual(colIdx, colIdx+numColumns) {
+				numColumns++
+			}
+			if numColumns > 1 {
+				align = rowConfig.getAutoMergeAlign()
+			}
+		}
+		t.latexRenderCell(out, colStr, align, numColumns)
+		colIdx += numColumns - 1
+	}
+	out.WriteString(" \\\\\n")
+}
+
+func (t *Table) latexRenderRows(out *strings.Builder, rows []rowStr, hint renderHint) {
+	for rowIdx, row := range rows {
+		hint.rowNumber = rowIdx + 1
+		t.latexRenderRow(out, row, hint)
+		if hint.isRegularRow() && rowIdx < len(rows)-1 &&
+			(t.style.Options.SeparateRows || t.separators[rowIdx]) {
+			out.WriteString("\\midrule\n")
+		}
+	}
+}
+
+func (t *Table) latexRenderRowsHeader(out *strings.Builder) bool {
+	if len(t.rowsHeader) > 0 {
+		t.latexRenderRows(out, t.rowsHeader, renderHint{isHeaderRow: true})
+		return true
+	} else if t.autoIndex {
+		hint := renderHint{isAutoIndexRow: true, isHeaderRow: true}
+		t.latexRenderRows(out, []rowStr{t.getAutoIndexColumnIDs()}, hint)
+		return true
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tinybit/go-pretty/v6/text"
+)
+
+func TestTable_RenderLaTeX(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(testHeader)
+	tw.AppendRows(testRows)
+	tw.AppendRow(testRowMultiLine)
+	tw.AppendRow(Row{5, "50% & $_{x}^~", "\\o/", 100, text.FgRed.Sprint("red")})
+	tw.AppendFooter(testFooter)
+	tw.SetCaption(testCaption)
+	tw.SetTitle(testTitle1)
+
+	compareOutput(t, tw.RenderLaTeX(), `
+\begin{table}
+\centering
+\caption{Game of Thrones}
+\begin{tabular}{rllrl}
+\toprule
+\# & First Name & Last Name & Salary &  \\
+\midrule
+1 & Arya & Stark & 3000 &  \\
+20 & Jon & Snow & 2000 & You know nothing, Jon Snow! \\
+300 & Tyrion & Lannister & 5000 &  \\
+0 & Winter & Is & 0 & \begin{tabular}[c]{@{}l@{}}Coming. \\ The North Remembers! \\ This is known.\end{tabular} \\
+5 & 50\% \& \$\_\{x\}\textasciicircum{}\textasciitilde{} & \textbackslash{}o/ & 100 & red \\
+\midrule
+ &  & Total & 10000 &  \\
+\bottomrule
+\end{tabular}
+\caption*{A Song of Ice and Fire}
+\end{table}`)
+}
+
+func TestTable_RenderLaTeX_AutoMerge(t *testing.T) {
+	rcAutoMerge := RowConfig{AutoMerge: true}
+	tw := NewWriter()
+	tw.AppendHeader(Row{"Node IP", "Pods", "Namespace", "Container", "RCE", "RCE"}, rcAutoMerge)
+	tw.AppendHeader(Row{"", "", "", "", "EXE", "RUN"})
+	tw.AppendRow(Row{"1.1.1.1", "Pod 1A", "NS 1A", "C 1", "Y", "Y"}, rcAutoMerge)
+	tw.AppendRow(Row{"1.1.1.1", "Pod 1A", "NS 1A", "C 2", "Y", "N"}, rcAutoMerge)
+	tw.AppendSeparator()
+	tw.AppendRow(Row{"2.2.2.2", "Pod 2", "NS 2", "C 3", "N", "N"}, rcAutoMerge)
+	tw.SetAutoIndex(true)
+
+	compareOutput(t, tw.RenderLaTeX(), `
+\begin{tabular}{rllllll}
+\toprule
+ & Node IP & Pods & Namespace & Container & \multicolumn{2}{c}{RCE} \\
+ &  &  &  &  & EXE & RUN \\
+\midrule
+1 & 1.1.1.1 & Pod 1A & NS 1A & C 1 & \multicolumn{2}{c}{Y} \\
+2 & 1.1.1.1 & Pod 1A & NS 1A & C 2 & Y & N \\
+\midrule
+3 & 2.2.2.2 & Pod 2 & NS 2 & C 3 & \multicolumn{2}{c}{N} \\
+\bottomrule
+\end{tabular}`)
+}
+
+func TestTable_RenderLaTeX_Empty(t *testing.T) {
+	tw := NewWriter()
+	assert.Empty(t, tw.RenderLaTeX())
+}
+
+func TestTable_RenderLaTeX_LongTable(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(testHeader)
+	tw.AppendRows(testRows)
+	tw.SetCaption(testCaption)
+	tw.SetColumnConfigs([]ColumnConfig{{Name: "First Name", Align: text.AlignCenter}})
+	tw.SetTitle(testTitle1)
+	tw.Style().LaTeX.LongTable = true
+
+	compareOutput(t, tw.RenderLaTeX(), `
+\begin{longtable}{rclrl}
+\caption{Game of Thrones} \\
+\toprule
+\# & First Name & Last Name & Salary &  \\
+\midrule
+\endhead
+1 & Arya & Stark & 3000 &  \\
+20 & Jon & Snow & 2000 & You know nothing, Jon Snow! \\
+300 & Tyrion & Lannister & 5000 &  \\
+\bottomrule
+\caption*{A Song of Ice and Fire} \\
+\end{longtable}`)
+}
@@ -54,6 +54,10 @@ func (t *Table) markdownRenderRow(out *strings.Builder, row rowStr, hint renderH
 		out.WriteRune(' ')
 		colStr = strings.ReplaceAll(colStr, "|", "\\|")
 		colStr = strings.ReplaceAll(colStr, "\n", "<br/>")
+		if colStr != "" && hint.isRegularRow() && t.isGroupRow(hint.rowNumber-1) {
+			// group header/subtotal rows are in bold
+			colStr = "**" + colStr + "**"
+		}
 		out.WriteString(colStr)
 		out.WriteRune(' ')
 		out.WriteRune('|')
@@ -0,0 +1,179 @@
+package table
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tinybit/go-pretty/v6/text"
+)
+
+// RenderRST renders the Table as a reStructuredText grid table. Example:
+//
+//	.. table:: Game of Thrones
+//
+//	   +-----+------------+-----------+--------+-----------------------------+
+//	   |   # | First Name | Last Name | Salary |                             |
+//	   +=====+============+===========+========+=============================+
+//	   |   1 | Arya       | Stark     |   3000 |                             |
+//	   +-----+------------+-----------+--------+-----------------------------+
+//	   |  20 | Jon        | Snow      |   2000 | You know nothing, Jon Snow! |
+//	   +-----+------------+-----------+--------+-----------------------------+
+//	   | 300 | Tyrion     | Lannister |   5000 |                             |
+//	   +-----+------------+-----------+--------+-----------------------------+
+//	   |     |            | Total     |  10000 |                             |
+//	   +-----+------------+-----------+--------+-----------------------------+
+//
+//	*A Song of Ice and Fire*
+//
+// The Title is rendered using the "table" directive, and the Caption as an
+// emphasized paragraph below the table. Like in Markdown, Footer rows are
+// rendered as regular rows and manual separators are ignored (as every row in
+// a grid table is separated anyway). ANSI escape sequences are stripped.
+func (t *Table) RenderRST() string {
+	t.initForRender(renderModeRST)
+
+	var out strings.Builder
+	if t.numColumns > 0 {
+		indent := ""
+		if t.title != "" {
+			out.WriteString(".. table:: ")
+			out.WriteString(strings.Join(strings.Fields(t.title), " "))
+			out.WriteRune('\n')
+			indent = "   "
+		}
+
+		rowsHeader, rows := t.rstGetRows()
+		widths := t.rstGetColumnWidths(rowsHeader, rows)
+		t.rstRenderSeparator(&out, indent, widths, "-")
+		for rowIdx, row := range rowsHeader {
+			t.rstRenderRow(&out, indent, widths, row, renderHint{isHeaderRow: true, rowNumber: rowIdx + 1})
+			if rowIdx < len(rowsHeader)-1 {
+				t.rstRenderSeparator(&out, indent, widths, "-")
+			}
+		}
+		if len(rowsHeader) > 0 {
+			t.rstRenderSeparator(&out, indent, widths, "=")
+		}
+		for rowIdx, row := range rows {
+			hint := renderHint{rowNumber: rowIdx + 1}
+			if rowIdx >= len(t.rows) {
+				hint = renderHint{isFooterRow: true, rowNumber: rowIdx - len(t.rows) + 1}
+			}
+			t.rstRenderRow(&out, indent, widths, row, hint)
+			t.rstRenderSeparator(&out, indent, widths, "-")
+		}
+
+		if t.caption != "" {
+			out.WriteString("\n\n*")
+			out.WriteString(strings.Join(strings.Fields(t.caption), " "))
+			out.WriteRune('*')
+		}
+	}
+	return t.render(&out)
+}
+
+// rstGetRows returns the header and the (regular and footer) rows with the
+// contents of each cell wrapped as needed, stripped of escape sequences, and
+// with the auto-index column prepended if enabled.
+func (t *Table) rstGetRows() ([]rowStr, []rowStr) {
+	prepare := func(rows []rowStr, hint renderHint) []rowStr {
+		var rsp []rowStr
+		for rowIdx, row := range rows {
+			var rowOut rowStr
+			if t.autoIndex {
+				if hint.isRegularRow() {
+					rowOut = append(rowOut, fmt.Sprint(rowIdx+1))
+				} else {
+					rowOut = append(rowOut, "")
+				}
+			}
+			for colIdx := 0; colIdx < t.numColumns; colIdx++ {
+				var colStr string
+				if colIdx < len(row) {
+					widthEnforcer := t.columnConfigMap[colIdx].getWidthMaxEnforcer()
+					colStr = widthEnforcer(row[colIdx], t.getColumnWidthMax(colIdx))
+				}
+				rowOut = append(rowOut, text.StripEscape(colStr))
+			}
+			rsp = append(rsp, rowOut)
+		}
+		return rsp
+	}
+
+	var rowsHeader []rowStr
+	if len(t.rowsHeader) > 0 {
+		rowsHeader = prepare(t.rowsHeader, renderHint{isHeaderRow: true})
+	} else if t.autoIndex {
+		rowsHeader = prepare([]rowStr{t.getAutoIndexColumnIDs()}, renderHint{isHeaderRow: true})
+	}
+	rows := prepare(t.rows, renderHint{})
+	rows = append(rows, prepare(t.rowsFooter, renderHint{isFooterRow: true})...)
+	return rowsHeader, rows
+}
+
+func (t *Table) rstGetColumnWidths(rowsHeader []rowStr, rows []rowStr) []int {
+	var widths []int
+	for _, row := range append(append([]rowStr{}, rowsHeader...), rows...) {
+		for colIdx, colStr := range row {
+			if colIdx >= len(widths) {
+				widths = append(widths, 0)
+			}
+			if colLen := text.LongestLineLen(colStr); colLen > widths[colIdx] {
+				widths[colIdx] = colLen
+			}
+		}
+	}
+	return widths
+}
+
+// rstGetAlign returns the alignment for the given column, accounting for the
+// auto-index column (if any) at the very beginning.
+func (t *Table) rstGetAlign(colIdx int, hint renderHint) text.Align {
+	if t.autoIndex {
+		if colIdx == 0 {
+			return text.AlignRight
+		}
+		colIdx--
+	}
+	return t.getAlign(colIdx, hint)
+}
+
+func (t *Table) rstRenderRow(out *strings.Builder, indent string, widths []int, row rowStr, hint renderHint) {
+	numLines := 1
+	for _, colStr := range row {
+		if colNumLines := strings.Count(colStr, "\n") + 1; colNumLines > numLines {
+			numLines = colNumLines
+		}
+	}
+
+	for lineIdx := 0; lineIdx < numLines; lineIdx++ {
+		out.WriteRune('\n')
+		out.WriteString(indent)
+		out.WriteRune('|')
+		for colIdx, width := range widths {
+			var line string
+			if colIdx < len(row) {
+				if lines := strings.Split(row[colIdx], "\n"); lineIdx < len(lines) {
+					line = lines[lineIdx]
+				}
+			}
+			out.WriteRune(' ')
+			out.WriteString(t.rstGetAlign(colIdx, hint).Apply(line, width))
+			out.WriteString(" |")
+		}
+	}
+}
+
+func (t *Table) rstRenderSeparator(out *strings.Builder, indent string, widths []int, char string) {
+	// when working on line number 2 or more, insert a newline first
+	if out.Len() > 0 {
+		out.WriteRune('\n')
+	}
+
+	out.WriteString(indent)
+	out.WriteRune('+')
+	for _, width := range widths {
+		out.WriteString(strings.Repeat(char, width+2))
+		out.WriteRune('+')
+	}
+}
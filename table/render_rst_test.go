@@ -0,0 +1,69 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTable_RenderRST(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(testHeader)
+	tw.AppendRows(testRows)
+	tw.AppendRow(testRowMultiLine)
+	tw.AppendSeparator()
+	tw.AppendFooter(testFooter)
+	tw.SetCaption(testCaption)
+	tw.SetTitle(testTitle1)
+
+	compareOutput(t, tw.RenderRST(), `
+.. table:: Game of Thrones
+
+   +-----+------------+-----------+--------+-----------------------------+
+   |   # | First Name | Last Name | Salary |                             |
+   +=====+============+===========+========+=============================+
+   |   1 | Arya       | Stark     |   3000 |                             |
+   +-----+------------+-----------+--------+-----------------------------+
+   |  20 | Jon        | Snow      |   2000 | You know nothing, Jon Snow! |
+   +-----+------------+-----------+--------+-----------------------------+
+   | 300 | Tyrion     | Lannister |   5000 |                             |
+   +-----+------------+-----------+--------+-----------------------------+
+   |   0 | Winter     | Is        |      0 | Coming.                     |
+   |     |            |           |        | The North Remembers!        |
+   |     |            |           |        | This is known.              |
+   +-----+------------+-----------+--------+-----------------------------+
+   |     |            | Total     |  10000 |                             |
+   +-----+------------+-----------+--------+-----------------------------+
+
+*A Song of Ice and Fire*`)
+}
+
+func TestTable_RenderRST_AutoIndex(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(testHeaderMultiLine)
+	tw.AppendHeader(Row{"", "", "", "USD"})
+	tw.AppendRows(testRows)
+	tw.SetAutoIndex(true)
+	tw.SetColumnConfigs([]ColumnConfig{{Number: 5, WidthMax: 10}})
+
+	compareOutput(t, tw.RenderRST(), `
++---+-----+--------+-----------+--------+------------+
+|   |   # | First  | Last      | Salary |            |
+|   |     | Name   | Name      |        |            |
++---+-----+--------+-----------+--------+------------+
+|   |     |        |           |    USD |            |
++===+=====+========+===========+========+============+
+| 1 |   1 | Arya   | Stark     |   3000 |            |
++---+-----+--------+-----------+--------+------------+
+| 2 |  20 | Jon    | Snow      |   2000 | You know n |
+|   |     |        |           |        | othing, Jo |
+|   |     |        |           |        | n Snow!    |
++---+-----+--------+-----------+--------+------------+
+| 3 | 300 | Tyrion | Lannister |   5000 |            |
++---+-----+--------+-----------+--------+------------+`)
+}
+
+func TestTable_RenderRST_Empty(t *testing.T) {
+	tw := NewWriter()
+	assert.Empty(t, tw.RenderRST())
+}
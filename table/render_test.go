@@ -6,6 +6,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/tinybit/go-pretty/v6/list"
 	"github.com/tinybit/go-pretty/v6/text"
 	"github.com/stretchr/testify/assert"
 )
@@ -1785,6 +1786,55 @@ func TestTable_Render_TableWithinTable(t *testing.T) {
 ╚═════════════════════════════════════════════════════════════════════════╝`)
 }
 
+func TestTable_Render_TableWithinTable_Renderable(t *testing.T) {
+	twInner := NewWriter()
+	twInner.AppendHeader(Row{"First Name", "Last Name"})
+	twInner.AppendRow(Row{"Arya", "Stark"})
+	twInner.AppendRow(Row{"Jon", "Snow"})
+	twInner.SetStyle(StyleLight)
+
+	lw := list.NewWriter()
+	lw.AppendItems([]interface{}{"Winter", "Is", "Coming, and it is a long one"})
+
+	twOuter := NewWriter()
+	twOuter.AppendHeader(Row{"House", "Members", "Words"})
+	twOuter.AppendRow(Row{"Stark", twInner, lw})
+
+	t.Run("no width limits", func(t *testing.T) {
+		compareOutput(t, twOuter.Render(), `
++-------+----------------------------+--------------------------------+
+| HOUSE | MEMBERS                    | WORDS                          |
++-------+----------------------------+--------------------------------+
+| Stark | ┌────────────┬───────────┐ | * Winter                       |
+|       | │ FIRST NAME │ LAST NAME │ | * Is                           |
+|       | ├────────────┼───────────┤ | * Coming, and it is a long one |
+|       | │ Arya       │ Stark     │ |                                |
+|       | │ Jon        │ Snow      │ |                                |
+|       | └────────────┴───────────┘ |                                |
++-------+----------------------------+--------------------------------+`)
+	})
+
+	t.Run("with width limits", func(t *testing.T) {
+		twOuter.SetColumnConfigs([]ColumnConfig{
+			{Number: 2, WidthMax: 20},
+			{Number: 3, WidthMax: 15},
+		})
+
+		compareOutput(t, twOuter.Render(), `
++-------+----------------------+-----------------+
+| HOUSE | MEMBERS              | WORDS           |
++-------+----------------------+-----------------+
+| Stark | ┌────────────┬──── ≈ | * Winter        |
+|       | │ FIRST NAME │ LAS ≈ | * Is            |
+|       | ├────────────┼──── ≈ | * Coming, and i |
+|       | │ Arya       │ Sta ≈ | t is a long one |
+|       | │ Jon        │ Sno ≈ |                 |
+|       | └────────────┴──── ≈ |                 |
++-------+----------------------+-----------------+`)
+		assert.Equal(t, 0, twInner.Style().Size.WidthMax, "nested table style should be restored")
+	})
+}
+
 func TestTable_Render_TableWithTransformers(t *testing.T) {
 	bolden := func(val interface{}) string {
 		return text.Bold.Sprint(val)
@@ -0,0 +1,265 @@
+package table
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tinybit/go-pretty/v6/text"
+)
+
+// initForRenderFitColumns tries to fit the Table within Style().Size.WidthMax
+// before falling back to truncating the rows. The columns with a Priority are
+// hidden first (lowest priority first) until the rest of the columns fit when
+// the shrinkable ones are shrunk down to their minimum widths; and then the
+// shrinkable columns are shrunk just enough to fit: the widest first, or all of
+// them in proportion to their widths with Style().Size.WidthDistribute.
+func (t *Table) initForRenderFitColumns() {
+	widthMax := t.style.Size.WidthMax
+	if widthMax <= 0 || t.maxRowLength <= widthMax || t.renderMode != renderModeDefault ||
+		t.transposed || t.style.Options.Expanded == ExpandedOn {
+		return
+	}
+
+	// hide the columns with the lowest priority
+	lenColumnPadding := text.StringWidthWithoutEscSequences(t.style.Box.PaddingLeft + t.style.Box.PaddingRight)
+	if t.style.Options.SeparateColumns {
+		lenColumnPadding += text.StringWidthWithoutEscSequences(t.style.Box.MiddleSeparator)
+	}
+	minWidths := t.getColumnWidthsShrunk()
+	minRowLength := t.maxRowLength
+	for colIdx, minWidth := range minWidths {
+		minRowLength -= t.maxColumnLengths[colIdx] - minWidth
+	}
+	for _, colIdx := range t.getColumnsByPriority() {
+		if minRowLength <= widthMax || t.numColumns-t.numColumnsHiddenToFit <= 1 {
+			break
+		}
+		minRowLength -= minWidths[colIdx] + lenColumnPadding
+		cc := t.columnConfigMap[colIdx]
+		cc.Hidden = true
+		t.columnConfigMap[colIdx] = cc
+		t.numColumnsHiddenToFit++
+	}
+	if t.numColumnsHiddenToFit > 0 {
+		t.initForRenderHideColumns()
+		t.initForRenderCellSpans()
+		t.initForRenderColumnLengths()
+		t.initForRenderMaxRowLength()
+		minWidths = t.getColumnWidthsShrunk()
+	}
+
+	// shrink the shrinkable columns just enough to fit
+	lenAvailable := widthMax - t.maxRowLength
+	for _, length := range t.maxColumnLengths {
+		lenAvailable += length
+	}
+	var lengths []int
+	if t.style.Size.WidthDistribute {
+		lengths = t.getColumnWidthsDistributed(minWidths, lenAvailable)
+	} else {
+		lengths = t.getColumnWidthsShrunkWidestFirst(minWidths, lenAvailable)
+	}
+	shrunk := false
+	for colIdx, length := range lengths {
+		if length < t.maxColumnLengths[colIdx] {
+			cc := t.columnConfigMap[colIdx]
+			cc.WidthMax = length
+			if cc.WidthMaxEnforcer == nil {
+				cc.WidthMaxEnforcer = text.WrapSoft
+			}
+			t.columnConfigMap[colIdx] = cc
+			shrunk = true
+		}
+	}
+	if shrunk {
+		t.initForRenderColumnLengths()
+		t.initForRenderMaxRowLength()
+	}
+}
+
+// getCaption returns the caption to render below the Table, with a note about
+// the columns hidden to fit within Style().Size.WidthMax if requested.
+func (t *Table) getCaption() string {
+	if t.numColumnsHiddenToFit == 0 || !t.style.Size.NoteHiddenColumns {
+		return t.caption
+	}
+
+	note := fmt.Sprintf("(%d columns hidden)", t.numColumnsHiddenToFit)
+	if t.numColumnsHiddenToFit == 1 {
+		note = "(1 column hidden)"
+	}
+	if t.caption == "" {
+		return note
+	}
+	return t.caption + "\n" + note
+}
+
+// getColumnWidthWeight returns the ColumnConfig.WidthWeight of the column, which
+// defaults to 1.
+func (t *Table) getColumnWidthWeight(colIdx int) int {
+	if weight := t.columnConfigMap[colIdx].WidthWeight; weight > 0 {
+		return weight
+	}
+	return 1
+}
+
+// getColumnWidthsDistributed returns the widths of the columns after taking
+// away the width over lenAvailable from all of them in proportion to their
+// widths (divided by their WidthWeight), without going under the minimum
+// widths; or under WidthMin (or 1) if the minimum widths do not fit either.
+func (t *Table) getColumnWidthsDistributed(minWidths []int, lenAvailable int) []int {
+	numColumns := len(t.maxColumnLengths)
+	lenTotal, lenMinTotal := 0, 0
+	for colIdx, length := range t.maxColumnLengths {
+		lenTotal += length
+		lenMinTotal += minWidths[colIdx]
+	}
+	if lenMinTotal > lenAvailable {
+		minWidths = make([]int, numColumns)
+		for colIdx := range minWidths {
+			minWidths[colIdx] = 1
+			if widthMin := t.getColumnWidthMin(colIdx); widthMin > 1 {
+				minWidths[colIdx] = widthMin
+			}
+			if minWidths[colIdx] > t.maxColumnLengths[colIdx] {
+				minWidths[colIdx] = t.maxColumnLengths[colIdx]
+			}
+		}
+	}
+
+	// take away the excess in proportion to the weighted widths; a column that
+	// hits its minimum width is frozen there, and the rest get re-distributed
+	// among the other columns
+	shares := make([]float64, numColumns)
+	frozen := make([]bool, numColumns)
+	for {
+		excess, weightTotal := float64(lenTotal-lenAvailable), 0.0
+		for colIdx, length := range t.maxColumnLengths {
+			if frozen[colIdx] {
+				excess -= float64(length - minWidths[colIdx])
+			} else {
+				weightTotal += float64(length) / float64(t.getColumnWidthWeight(colIdx))
+			}
+		}
+
+		clamped := false
+		for colIdx, length := range t.maxColumnLengths {
+			if frozen[colIdx] {
+				continue
+			}
+			shares[colIdx] = float64(length)
+			if excess > 0 && weightTotal > 0 {
+				shares[colIdx] -= excess * float64(length) / float64(t.getColumnWidthWeight(colIdx)) / weightTotal
+			}
+			if shares[colIdx] < float64(minWidths[colIdx]) {
+				shares[colIdx] = float64(minWidths[colIdx])
+				frozen[colIdx] = true
+				clamped = true
+			}
+		}
+		if !clamped {
+			break
+		}
+	}
+
+	// round down, and hand out the rest to the columns with the largest
+	// fractions left behind
+	widths := make([]int, numColumns)
+	lenRemaining := lenAvailable
+	for colIdx, share := range shares {
+		widths[colIdx] = int(share)
+		lenRemaining -= widths[colIdx]
+	}
+	for ; lenRemaining > 0; lenRemaining-- {
+		colIdxLargest, fractionLargest := -1, 0.0
+		for colIdx, share := range shares {
+			fraction := share - float64(widths[colIdx])
+			if widths[colIdx] < t.maxColumnLengths[colIdx] && fraction > fractionLargest {
+				colIdxLargest, fractionLargest = colIdx, fraction
+			}
+		}
+		if colIdxLargest < 0 {
+			break
+		}
+		widths[colIdxLargest]++
+	}
+	return widths
+}
+
+// getColumnWidthsShrunk returns the minimum width of each column; which is the
+// current width for the columns that are not shrinkable.
+func (t *Table) getColumnWidthsShrunk() []int {
+	widths := make([]int, len(t.maxColumnLengths))
+	for colIdx, length := range t.maxColumnLengths {
+		widths[colIdx] = length
+		cc := t.columnConfigMap[colIdx]
+		if !cc.WidthShrinkable && !t.style.Size.WidthDistribute {
+			continue
+		}
+
+		widthMin := cc.WidthMin
+		if widthMin <= 0 {
+			widthMin = 1
+			for _, rows := range [][]rowStr{t.rowsHeader, t.rows, t.rowsFooter} {
+				for _, row := range rows {
+					if colIdx < len(row) {
+						for _, word := range strings.Fields(row[colIdx]) {
+							if lenWord := text.StringWidthWithoutEscSequences(word); lenWord > widthMin {
+								widthMin = lenWord
+							}
+						}
+					}
+				}
+			}
+		}
+		if widthMin < length {
+			widths[colIdx] = widthMin
+		}
+	}
+	return widths
+}
+
+// getColumnWidthsShrunkWidestFirst returns the widths of the columns after
+// taking away the width over lenAvailable from the widest column(s) one
+// character at a time, without going under the minimum widths.
+func (t *Table) getColumnWidthsShrunkWidestFirst(minWidths []int, lenAvailable int) []int {
+	widths := make([]int, len(t.maxColumnLengths))
+	copy(widths, t.maxColumnLengths)
+	excess := -lenAvailable
+	for _, width := range widths {
+		excess += width
+	}
+	for ; excess > 0; excess-- {
+		colIdxWidest := -1
+		for colIdx, width := range widths {
+			if width > minWidths[colIdx] && (colIdxWidest < 0 || width > widths[colIdxWidest]) {
+				colIdxWidest = colIdx
+			}
+		}
+		if colIdxWidest < 0 {
+			break
+		}
+		widths[colIdxWidest]--
+	}
+	return widths
+}
+
+// getColumnsByPriority returns the columns that may be hidden to fit within
+// Style().Size.WidthMax in the order they should be hidden.
+func (t *Table) getColumnsByPriority() []int {
+	var columns []int
+	for colIdx := 0; colIdx < t.numColumns; colIdx++ {
+		if t.columnConfigMap[colIdx].Priority > 0 {
+			columns = append(columns, colIdx)
+		}
+	}
+	sort.SliceStable(columns, func(i, j int) bool {
+		pi, pj := t.columnConfigMap[columns[i]].Priority, t.columnConfigMap[columns[j]].Priority
+		if pi != pj {
+			return pi > pj
+		}
+		return columns[i] > columns[j]
+	})
+	return columns
+}
@@ -0,0 +1,176 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/tinybit/go-pretty/v6/text"
+)
+
+func TestTable_Render_FitColumns(t *testing.T) {
+	newTable := func() Writer {
+		tw := NewWriter()
+		tw.AppendHeader(Row{"ID", "Name", "Email", "Notes"})
+		tw.AppendRows([]Row{
+			{1, "Arya Stark", "arya@winterfell.org", "a girl has no name"},
+			{2, "Jon Snow", "jon@nightswatch.org", "you know nothing"},
+		})
+		tw.AppendFooter(Row{"", "", "Total", 2})
+		return tw
+	}
+
+	t.Run("shrink", func(t *testing.T) {
+		tw := newTable()
+		tw.SetColumnConfigs([]ColumnConfig{
+			{Name: "Notes", WidthShrinkable: true},
+		})
+		tw.Style().Size.WidthMax = 60
+
+		compareOutput(t, tw.Render(), `
++----+------------+---------------------+------------------+
+| ID | NAME       | EMAIL               | NOTES            |
++----+------------+---------------------+------------------+
+|  1 | Arya Stark | arya@winterfell.org | a girl has no    |
+|    |            |                     | name             |
+|  2 | Jon Snow   | jon@nightswatch.org | you know nothing |
++----+------------+---------------------+------------------+
+|    |            | TOTAL               | 2                |
++----+------------+---------------------+------------------+`)
+	})
+
+	t.Run("hide", func(t *testing.T) {
+		tw := newTable()
+		tw.SetColumnConfigs([]ColumnConfig{
+			{Name: "ID", Priority: 1},
+			{Name: "Email", Priority: 3},
+			{Name: "Notes", Priority: 2, WidthShrinkable: true},
+		})
+		tw.SetCaption("Characters")
+		tw.Style().Size.NoteHiddenColumns = true
+		tw.Style().Size.WidthMax = 45
+
+		compareOutput(t, tw.Render(), `
++----+------------+--------------------+
+| ID | NAME       | NOTES              |
++----+------------+--------------------+
+|  1 | Arya Stark | a girl has no name |
+|  2 | Jon Snow   | you know nothing   |
++----+------------+--------------------+
+|    |            | 2                  |
++----+------------+--------------------+
+Characters
+(1 column hidden)`)
+
+		tw.Style().Size.WidthMax = 25
+		compareOutput(t, tw.Render(), `
++----+------------+
+| ID | NAME       |
++----+------------+
+|  1 | Arya Stark |
+|  2 | Jon Snow   |
++----+------------+
+|    |            |
++----+------------+
+Characters
+(2 columns hidden)`)
+
+		tw.Style().Size.WidthMax = 10
+		compareOutput(t, tw.Render(), `
++------- ~
+| NAME   ~
++------- ~
+| Arya S ~
+| Jon Sn ~
++------- ~
+|        ~
++------- ~
+Characters
+(3 columns hidden)`)
+
+		tw.Style().Size.WidthMax = 0
+		compareOutput(t, tw.Render(), `
++----+------------+---------------------+--------------------+
+| ID | NAME       | EMAIL               | NOTES              |
++----+------------+---------------------+--------------------+
+|  1 | Arya Stark | arya@winterfell.org | a girl has no name |
+|  2 | Jon Snow   | jon@nightswatch.org | you know nothing   |
++----+------------+---------------------+--------------------+
+|    |            | TOTAL               | 2                  |
++----+------------+---------------------+--------------------+
+Characters`)
+	})
+	t.Run("hide with hidden columns", func(t *testing.T) {
+		tw := newTable()
+		tw.SetColumnConfigs([]ColumnConfig{
+			{Name: "ID", Hidden: true},
+			{Name: "Email", Priority: 1},
+			{Name: "Notes", Align: text.AlignRight, AlignFooter: text.AlignRight},
+		})
+		tw.Style().Size.WidthMax = 40
+
+		compareOutput(t, tw.Render(), `
++------------+--------------------+
+| NAME       | NOTES              |
++------------+--------------------+
+| Arya Stark | a girl has no name |
+| Jon Snow   |   you know nothing |
++------------+--------------------+
+|            |                  2 |
++------------+--------------------+`)
+	})
+	t.Run("distribute", func(t *testing.T) {
+		tw := newTable()
+		tw.Style().Size.WidthDistribute = true
+		tw.Style().Size.WidthMax = 50
+
+		compareOutput(t, tw.Render(), `
++----+--------+---------------------+------------+
+| ID | NAME   | EMAIL               | NOTES      |
++----+--------+---------------------+------------+
+|  1 | Arya   | arya@winterfell.org | a girl has |
+|    | Stark  |                     | no name    |
+|  2 | Jon    | jon@nightswatch.org | you know   |
+|    | Snow   |                     | nothing    |
++----+--------+---------------------+------------+
+|    |        | TOTAL               | 2          |
++----+--------+---------------------+------------+`)
+
+		tw.SetColumnConfigs([]ColumnConfig{
+			{Name: "Notes", WidthMaxEnforcer: text.WrapHard, WidthWeight: 3},
+		})
+		compareOutput(t, tw.Render(), `
++----+-------+---------------------+-------------+
+| ID | NAME  | EMAIL               | NOTES       |
++----+-------+---------------------+-------------+
+|  1 | Arya  | arya@winterfell.org | a girl has  |
+|    | Stark |                     | no name     |
+|  2 | Jon   | jon@nightswatch.org | you know no |
+|    | Snow  |                     | thing       |
++----+-------+---------------------+-------------+
+|    |       | TOTAL               | 2           |
++----+-------+---------------------+-------------+`)
+
+		tw.SetColumnConfigs([]ColumnConfig{
+			{Name: "Name", WidthMin: 8},
+		})
+		tw.Style().Size.WidthMax = 30
+		compareOutput(t, tw.Render(), `
++---+----------+------+------+
+| I | NAME     | EMAI | NOTE |
+| D |          | L    | S    |
++---+----------+------+------+
+| 1 | Arya     | arya | a    |
+|   | Stark    | @win | girl |
+|   |          | terf | has  |
+|   |          | ell. | no   |
+|   |          | org  | name |
+| 2 | Jon Snow | jon@ | you  |
+|   |          | nigh | know |
+|   |          | tswa | noth |
+|   |          | tch. | ing  |
+|   |          | org  |      |
++---+----------+------+------+
+|   |          | TOTA | 2    |
+|   |          | L    |      |
++---+----------+------+------+`)
+	})
+}
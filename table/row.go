@@ -9,6 +9,25 @@ import (
 // Row defines a single row in the Table.
 type Row []interface{}
 
+// Renderable is anything that can render itself as a block of text, like a
+// table.Writer or a list.Writer, and can be used as a value in a Row. Example:
+//
+//	tw.AppendRow(Row{"Stark", nestedTableWriter})
+//
+// If the column has a WidthMax set, a nested table.Writer is rendered with its
+// Style().Size.WidthMax capped to it. In HTML mode, values that also implement
+// "RenderHTML() string" are rendered as nested HTML elements (like a <table> or
+// a <ul>) instead of as escaped text.
+type Renderable interface {
+	Render() string
+}
+
+// renderableHTML is a Renderable that can also render itself as HTML.
+type renderableHTML interface {
+	Renderable
+	RenderHTML() string
+}
+
 func (r Row) findColumnNumber(colName string) int {
 	for colIdx, col := range r {
 		if fmt.Sprint(col) == colName {
@@ -1,9 +1,16 @@
 package table
 
 import (
+	"net"
+	"net/netip"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
+	"unicode"
+
+	"github.com/tinybit/go-pretty/v6/text"
+	"golang.org/x/text/collate"
 )
 
 // SortBy defines What to sort (Column Name or Number), and How to sort (Mode).
@@ -22,6 +29,16 @@ type SortBy struct {
 	// IgnoreCase makes sorting case-insensitive
 	IgnoreCase bool
 
+	// Collator is used (if set) to compare strings as per the rules of a
+	// language, instead of comparing them byte-wise. This helps sort accented
+	// and non-Latin text correctly. For ex.:
+	//   collate.New(language.German, collate.IgnoreCase)
+	// Note that:
+	// * This applies to the alphabetic comparisons of all the Modes
+	// * This overrides IgnoreCase; use the collate.IgnoreCase option instead
+	// * A Collator is not safe for concurrent use
+	Collator *collate.Collator
+
 	// CustomLess is a function that can be used to sort the column in a custom
 	// manner. Note that:
 	// * This overrides and ignores the Mode and IgnoreCase settings
@@ -59,8 +76,55 @@ const (
 	// DscNumericAlpha sorts the column in Descending order numerically and
 	// then alphabetically.
 	DscNumericAlpha
+
+	// AscDuration sorts the column in Ascending order of time.Duration values
+	// or strings like "1h30m".
+	AscDuration
+	// AscIP sorts the column in Ascending order of IP addresses or CIDR blocks
+	// with IPv4 addresses ahead of IPv6 addresses.
+	AscIP
+	// AscNatural sorts the column in Ascending order alphabetically, but with
+	// the numbers within compared numerically ("file2" < "file10").
+	AscNatural
+	// AscSemVer sorts the column in Ascending order of semantic versions like
+	// "v1.2.3" or "1.2.3-rc.1".
+	AscSemVer
+	// AscTime sorts the column in Ascending order of time.Time values or
+	// strings in one of the well-known time layouts (like RFC3339).
+	AscTime
+	// DscDuration sorts the column in Descending order of time.Duration values
+	// or strings like "1h30m".
+	DscDuration
+	// DscIP sorts the column in Descending order of IP addresses or CIDR blocks
+	// with IPv4 addresses ahead of IPv6 addresses.
+	DscIP
+	// DscNatural sorts the column in Descending order alphabetically, but with
+	// the numbers within compared numerically ("file10" > "file2").
+	DscNatural
+	// DscSemVer sorts the column in Descending order of semantic versions like
+	// "v1.2.3" or "1.2.3-rc.1".
+	DscSemVer
+	// DscTime sorts the column in Descending order of time.Time values or
+	// strings in one of the well-known time layouts (like RFC3339).
+	DscTime
 )
 
+// sortTimeLayouts are the layouts tried (in order) to parse the strings being
+// sorted using AscTime/DscTime that text.ParseTime does not understand.
+var sortTimeLayouts = []string{
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+	time.RFC1123Z,
+	time.RFC1123,
+	time.UnixDate,
+}
+
+// isTyped returns true if the SortMode compares the raw values of a column
+// as a specific type (and not as text or numbers).
+func (sm SortMode) isTyped() bool {
+	return sm >= AscDuration && sm <= DscTime
+}
+
 // getSortedRowIndices sorts and returns the row indices in Sorted order as
 // directed by Table.sortBy which can be set using Table.SortBy(...)
 func (t *Table) getSortedRowIndices() []int {
@@ -86,7 +150,13 @@ func (t *Table) getSortedRowIndices() []int {
 				}
 
 				// compare and choose whether to continue
-				isEqual, isLess = less(iVal, jVal, sortBy)
+				if sortBy.CustomLess == nil && sortBy.Mode.isTyped() {
+					isEqual, isLess = lessTyped(
+						t.getSortValue(realI, colIdx, iVal), t.getSortValue(realJ, colIdx, jVal), sortBy,
+					)
+				} else {
+					isEqual, isLess = less(iVal, jVal, sortBy)
+				}
 				// if the values are not equal, return the result immediately
 				if !isEqual {
 					return isLess
@@ -100,6 +170,17 @@ func (t *Table) getSortedRowIndices() []int {
 	return sortedIndices
 }
 
+// getSortValue returns the raw value of the given cell if available, and its
+// string form otherwise.
+func (t *Table) getSortValue(rowIdx int, colIdx int, str string) interface{} {
+	if rowIdx < len(t.rowsRawFiltered) && colIdx < len(t.rowsRawFiltered[rowIdx]) {
+		if !t.getFilteredRowCellSpans(rowIdx).isCovered(colIdx) {
+			return t.rowsRawFiltered[rowIdx][colIdx]
+		}
+	}
+	return str
+}
+
 func (t *Table) parseSortBy(sortBy []SortBy) []SortBy {
 	var resSortBy []SortBy
 	for _, col := range sortBy {
@@ -120,6 +201,7 @@ func (t *Table) parseSortBy(sortBy []SortBy) []SortBy {
 				Number:     colNum,
 				Mode:       col.Mode,
 				IgnoreCase: col.IgnoreCase,
+				Collator:   col.Collator,
 				CustomLess: col.CustomLess,
 			})
 		}
@@ -157,6 +239,18 @@ func less(iVal string, jVal string, sb SortBy) (bool, bool) {
 }
 
 func lessAlphabetic(iVal string, jVal string, sb SortBy) (bool, bool) {
+	if sb.Collator != nil {
+		cmp := sb.Collator.CompareString(iVal, jVal)
+		// when two strings are identical as per the collator, compare them
+		// byte-wise to get a consistent sorting
+		identical := cmp == 0
+		switch sb.Mode {
+		case Asc, AscAlphaNumeric, AscNumericAlpha:
+			return identical, (identical && iVal < jVal) || cmp < 0
+		default: // Dsc, DscAlphaNumeric, DscNumericAlpha
+			return identical, (identical && iVal > jVal) || cmp > 0
+		}
+	}
 	if sb.IgnoreCase {
 		iLow := strings.ToLower(iVal)
 		jLow := strings.ToLower(jVal)
@@ -226,3 +320,260 @@ func lessNumericVal(iVal float64, jVal float64, sb SortBy) (bool, bool) {
 		return false, iVal > jVal
 	}
 }
+
+// lessTyped compares the raw values as directed by one of the typed SortModes.
+// Values that cannot be interpreted as the type get sorted after the ones that
+// can be, and alphabetically amongst themselves.
+func lessTyped(iVal interface{}, jVal interface{}, sb SortBy) (bool, bool) {
+	var cmp int
+	iOK, jOK := true, true
+	switch sb.Mode {
+	case AscDuration, DscDuration:
+		var iDur, jDur time.Duration
+		iDur, iOK = sortParseDuration(iVal)
+		jDur, jOK = sortParseDuration(jVal)
+		cmp = compareInt64(int64(iDur), int64(jDur))
+	case AscIP, DscIP:
+		var iAddr, jAddr netip.Addr
+		var iBits, jBits int
+		iAddr, iBits, iOK = sortParseIP(iVal)
+		jAddr, jBits, jOK = sortParseIP(jVal)
+		if cmp = iAddr.Compare(jAddr); cmp == 0 {
+			cmp = compareInt64(int64(iBits), int64(jBits))
+		}
+	case AscNatural, DscNatural:
+		cmp = compareNatural(convertValueToString(iVal), convertValueToString(jVal), sb)
+	case AscSemVer, DscSemVer:
+		var iVer, jVer semVer
+		iVer, iOK = sortParseSemVer(iVal)
+		jVer, jOK = sortParseSemVer(jVal)
+		cmp = iVer.compare(jVer)
+	case AscTime, DscTime:
+		var iTime, jTime time.Time
+		iTime, iOK = sortParseTime(iVal)
+		jTime, jOK = sortParseTime(jVal)
+		if iTime.Before(jTime) {
+			cmp = -1
+		} else if iTime.After(jTime) {
+			cmp = 1
+		}
+	}
+
+	if !iOK || !jOK {
+		if iOK != jOK {
+			return false, iOK
+		}
+		return lessAlphabetic(convertValueToString(iVal), convertValueToString(jVal), SortBy{Mode: Asc, IgnoreCase: sb.IgnoreCase})
+	}
+	if cmp == 0 {
+		return true, false
+	}
+	switch sb.Mode {
+	case AscDuration, AscIP, AscNatural, AscSemVer, AscTime:
+		return false, cmp < 0
+	default: // DscDuration, DscIP, DscNatural, DscSemVer, DscTime
+		return false, cmp > 0
+	}
+}
+
+func compareInt64(a int64, b int64) int {
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+	return 0
+}
+
+func compareUint64(a uint64, b uint64) int {
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+	return 0
+}
+
+// compareNatural compares the strings alphabetically (using the Collator if
+// set), except for sequences of digits which are compared numerically.
+func compareNatural(a string, b string, sb SortBy) int {
+	if sb.IgnoreCase && sb.Collator == nil {
+		// when two strings are case-insensitive identical, compare them
+		// case-sensitive to get a consistent sorting
+		if cmp := compareNatural(strings.ToLower(a), strings.ToLower(b), SortBy{}); cmp != 0 {
+			return cmp
+		}
+	}
+
+	aRest, bRest := a, b
+	for aRest != "" && bRest != "" {
+		aChunk, aIsNum := naturalChunk(aRest)
+		bChunk, bIsNum := naturalChunk(bRest)
+		aRest, bRest = aRest[len(aChunk):], bRest[len(bChunk):]
+		if aIsNum && bIsNum {
+			aChunk, bChunk = strings.TrimLeft(aChunk, "0"), strings.TrimLeft(bChunk, "0")
+			if cmp := compareInt64(int64(len(aChunk)), int64(len(bChunk))); cmp != 0 {
+				return cmp
+			}
+		}
+		if aIsNum && bIsNum || sb.Collator == nil {
+			if cmp := strings.Compare(aChunk, bChunk); cmp != 0 {
+				return cmp
+			}
+		} else if cmp := sb.Collator.CompareString(aChunk, bChunk); cmp != 0 {
+			return cmp
+		}
+	}
+	if cmp := compareInt64(int64(len(aRest)), int64(len(bRest))); cmp != 0 {
+		return cmp
+	}
+	// differ only in the leading zeroes of the numbers
+	return strings.Compare(a, b)
+}
+
+// naturalChunk returns the leading sequence of digits or non-digits.
+func naturalChunk(s string) (string, bool) {
+	isNum := s[0] >= '0' && s[0] <= '9'
+	for idx := 1; idx < len(s); idx++ {
+		if (s[idx] >= '0' && s[idx] <= '9') != isNum {
+			return s[:idx], isNum
+		}
+	}
+	return s, isNum
+}
+
+func sortParseDuration(val interface{}) (time.Duration, bool) {
+	if dur, ok := val.(time.Duration); ok {
+		return dur, true
+	}
+	dur, err := time.ParseDuration(strings.TrimSpace(convertValueToString(val)))
+	return dur, err == nil
+}
+
+// sortParseIP returns the IP address and the prefix length (the number of bits
+// in the address if not a CIDR block) of the given value.
+func sortParseIP(val interface{}) (netip.Addr, int, bool) {
+	switch v := val.(type) {
+	case net.IP:
+		if addr, ok := netip.AddrFromSlice(v); ok {
+			return addr.Unmap(), addr.Unmap().BitLen(), true
+		}
+		return netip.Addr{}, 0, false
+	case *net.IPNet:
+		if v == nil {
+			return netip.Addr{}, 0, false
+		}
+		return sortParseIP(*v)
+	case net.IPNet:
+		addr, ok := netip.AddrFromSlice(v.IP)
+		ones, _ := v.Mask.Size()
+		return addr.Unmap(), ones, ok
+	case netip.Addr:
+		return v, v.BitLen(), v.IsValid()
+	case netip.Prefix:
+		return v.Addr(), v.Bits(), v.IsValid()
+	}
+
+	str := strings.TrimSpace(convertValueToString(val))
+	if strings.Contains(str, "/") {
+		prefix, err := netip.ParsePrefix(str)
+		return prefix.Addr().Unmap(), prefix.Bits(), err == nil
+	}
+	addr, err := netip.ParseAddr(str)
+	return addr.Unmap(), addr.Unmap().BitLen(), err == nil
+}
+
+func sortParseTime(val interface{}) (time.Time, bool) {
+	switch v := val.(type) {
+	case time.Time:
+		return v, true
+	case *time.Time:
+		if v != nil {
+			return *v, true
+		}
+		return time.Time{}, false
+	}
+
+	str := strings.TrimSpace(convertValueToString(val))
+	if t, ok := text.ParseTime(str); ok {
+		return t, true
+	}
+	for _, layout := range sortTimeLayouts {
+		if t, err := time.Parse(layout, str); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// semVer is a semantic version as defined by https://semver.org (with the
+// minor and patch versions being optional).
+type semVer struct {
+	core       [3]uint64
+	preRelease []string
+}
+
+func (v semVer) compare(other semVer) int {
+	for idx := range v.core {
+		if cmp := compareUint64(v.core[idx], other.core[idx]); cmp != 0 {
+			return cmp
+		}
+	}
+
+	// a pre-release version has lower precedence than the normal version
+	if len(v.preRelease) == 0 || len(other.preRelease) == 0 {
+		return compareInt64(int64(len(other.preRelease)), int64(len(v.preRelease)))
+	}
+	for idx := 0; idx < len(v.preRelease) && idx < len(other.preRelease); idx++ {
+		a, b := v.preRelease[idx], other.preRelease[idx]
+		aNum, aErr := strconv.ParseUint(a, 10, 64)
+		bNum, bErr := strconv.ParseUint(b, 10, 64)
+		var cmp int
+		switch {
+		case aErr == nil && bErr == nil:
+			cmp = compareUint64(aNum, bNum)
+		case aErr == nil: // numeric identifiers have lower precedence
+			cmp = -1
+		case bErr == nil:
+			cmp = 1
+		default:
+			cmp = strings.Compare(a, b)
+		}
+		if cmp != 0 {
+			return cmp
+		}
+	}
+	return compareInt64(int64(len(v.preRelease)), int64(len(other.preRelease)))
+}
+
+func sortParseSemVer(val interface{}) (semVer, bool) {
+	str := strings.TrimSpace(convertValueToString(val))
+	str = strings.TrimPrefix(strings.TrimPrefix(str, "v"), "V")
+	if idx := strings.IndexByte(str, '+'); idx >= 0 {
+		str = str[:idx] // build metadata does not figure in precedence
+	}
+
+	var rsp semVer
+	core, preRelease, hasPreRelease := strings.Cut(str, "-")
+	coreParts := strings.Split(core, ".")
+	if len(coreParts) > len(rsp.core) {
+		return semVer{}, false
+	}
+	for idx, part := range coreParts {
+		if part == "" || strings.IndexFunc(part, func(r rune) bool { return !unicode.IsDigit(r) }) >= 0 {
+			return semVer{}, false
+		}
+		num, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return semVer{}, false
+		}
+		rsp.core[idx] = num
+	}
+	if hasPreRelease {
+		if preRelease == "" {
+			return semVer{}, false
+		}
+		rsp.preRelease = strings.Split(preRelease, ".")
+	}
+	return rsp, true
+}
@@ -1,9 +1,14 @@
 package table
 
 import (
+	"net"
+	"net/netip"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
 )
 
 func TestTable_sortRows_MissingCells(t *testing.T) {
@@ -266,3 +271,125 @@ func TestTable_sortRows_CustomLess(t *testing.T) {
 		assert.Equal(t, []int{1, 2, 0}, table.getSortedRowIndices())
 	})
 }
+
+func TestTable_sortRows_Typed(t *testing.T) {
+	sortColumn := func(mode SortMode, values ...interface{}) []int {
+		table := Table{}
+		for _, value := range values {
+			table.AppendRow(Row{value})
+		}
+		table.SetColumnConfigs([]ColumnConfig{{
+			Number:      1,
+			Transformer: func(val interface{}) string { return "transformed" },
+		}})
+		table.initForRenderRows()
+		table.SortBy([]SortBy{{Number: 1, Mode: mode}})
+		return table.getSortedRowIndices()
+	}
+
+	t.Run("Duration", func(t *testing.T) {
+		values := []interface{}{"1h", 90 * time.Second, "foo", "2m", time.Millisecond}
+		assert.Equal(t, []int{4, 1, 3, 0, 2}, sortColumn(AscDuration, values...))
+		assert.Equal(t, []int{0, 3, 1, 4, 2}, sortColumn(DscDuration, values...))
+	})
+
+	t.Run("IP", func(t *testing.T) {
+		values := []interface{}{
+			"10.0.0.10", net.ParseIP("10.0.0.9"), "::1", "host", "10.0.0.0/8",
+			netip.MustParseAddr("2.2.2.2"), &net.IPNet{IP: net.ParseIP("10.0.0.0"), Mask: net.CIDRMask(24, 32)},
+		}
+		assert.Equal(t, []int{5, 4, 6, 1, 0, 2, 3}, sortColumn(AscIP, values...))
+		assert.Equal(t, []int{2, 0, 1, 6, 4, 5, 3}, sortColumn(DscIP, values...))
+	})
+
+	t.Run("Natural", func(t *testing.T) {
+		values := []interface{}{"file10", "file2", "file02", "File1", "file", 3}
+		assert.Equal(t, []int{5, 3, 4, 2, 1, 0}, sortColumn(AscNatural, values...))
+		assert.Equal(t, []int{0, 1, 2, 4, 3, 5}, sortColumn(DscNatural, values...))
+
+		table := Table{}
+		table.AppendRows([]Row{{"file10"}, {"File2"}, {"file2"}, {"file1"}})
+		table.initForRenderRows()
+		table.SortBy([]SortBy{{Number: 1, Mode: AscNatural, IgnoreCase: true}})
+		assert.Equal(t, []int{3, 1, 2, 0}, table.getSortedRowIndices())
+	})
+
+	t.Run("SemVer", func(t *testing.T) {
+		values := []interface{}{
+			"v1.10.0", "1.2.0", "1.2.0-rc.1", "1.2.0-beta.11", "1.2.0-beta.2", "1.2.0-alpha", "1.2.0-alpha.1", "2", "latest", "v1.2.0+build.5",
+		}
+		assert.Equal(t, []int{5, 6, 4, 3, 2, 1, 9, 0, 7, 8}, sortColumn(AscSemVer, values...))
+		assert.Equal(t, []int{7, 0, 1, 9, 2, 3, 4, 6, 5, 8}, sortColumn(DscSemVer, values...))
+	})
+
+	t.Run("Time", func(t *testing.T) {
+		values := []interface{}{
+			"2024-03-01T10:00:00Z",
+			time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+			"2024-02-01",
+			"yesterday",
+			"2024-03-01T09:00:00-02:00",
+			nil,
+		}
+		assert.Equal(t, []int{1, 2, 0, 4, 5, 3}, sortColumn(AscTime, values...))
+		assert.Equal(t, []int{4, 0, 2, 1, 5, 3}, sortColumn(DscTime, values...))
+	})
+
+	t.Run("Render", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendHeader(Row{"Version", "Released"})
+		tw.AppendRows([]Row{
+			{"v1.10.0", "2024-06-01"},
+			{"v1.9.2", "2024-04-11"},
+			{"v1.9.10", "2024-05-02"},
+		})
+		tw.SortBy([]SortBy{{Name: "Version", Mode: DscSemVer}})
+		compareOutput(t, tw.Render(), `
++---------+------------+
+| VERSION | RELEASED   |
++---------+------------+
+| v1.10.0 | 2024-06-01 |
+| v1.9.10 | 2024-05-02 |
+| v1.9.2  | 2024-04-11 |
++---------+------------+`)
+	})
+}
+
+func TestTable_sortRows_Collator(t *testing.T) {
+	table := Table{}
+	table.AppendHeader(Row{"Name"})
+	table.AppendRows([]Row{
+		/* 0 */ {"Zoë"},
+		/* 1 */ {"Örjan"},
+		/* 2 */ {"emil"},
+		/* 3 */ {"Émile"},
+		/* 4 */ {"Oskar"},
+		/* 5 */ {"Emil"},
+	})
+	table.initForRenderRows()
+
+	table.SortBy([]SortBy{{Name: "Name", Mode: Asc}})
+	assert.Equal(t, []int{5, 4, 0, 2, 3, 1}, table.getSortedRowIndices())
+
+	table.SortBy([]SortBy{{Name: "Name", Mode: Asc, Collator: collate.New(language.German)}})
+	assert.Equal(t, []int{2, 5, 3, 1, 4, 0}, table.getSortedRowIndices())
+
+	table.SortBy([]SortBy{{Name: "Name", Mode: Dsc, Collator: collate.New(language.German)}})
+	assert.Equal(t, []int{0, 4, 1, 3, 5, 2}, table.getSortedRowIndices())
+
+	// Swedish sorts "Ö" after "Z"
+	table.SortBy([]SortBy{{Name: "Name", Mode: Asc, Collator: collate.New(language.Swedish)}})
+	assert.Equal(t, []int{2, 5, 3, 4, 0, 1}, table.getSortedRowIndices())
+
+	// identical as per the collator => byte-wise
+	table.SortBy([]SortBy{{Name: "Name", Mode: Asc, Collator: collate.New(language.German, collate.IgnoreCase)}})
+	assert.Equal(t, []int{5, 2, 3, 1, 4, 0}, table.getSortedRowIndices())
+
+	t.Run("Natural", func(t *testing.T) {
+		table := Table{}
+		table.AppendRows([]Row{{"Été 10"}, {"ete 2"}, {"Eta 3"}})
+		table.initForRenderRows()
+		table.SortBy([]SortBy{{Number: 1, Mode: AscNatural, Collator: collate.New(language.French)}})
+		assert.Equal(t, []int{2, 1, 0}, table.getSortedRowIndices())
+	})
+}
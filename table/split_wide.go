@@ -0,0 +1,124 @@
+package table
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tinybit/go-pretty/v6/text"
+)
+
+// getSplitWidePanes returns the (raw) columns to render in each pane if the
+// Table does not fit within Style().Size.WidthMax and has to be split into
+// panes as directed by Style().Size.SplitWide. Each pane gets as many columns
+// as fit (at least one) in addition to the index column.
+func (t *Table) getSplitWidePanes() []map[int]bool {
+	widthMax := t.style.Size.WidthMax
+	if !t.style.Size.SplitWide || widthMax <= 0 || t.maxRowLength <= widthMax ||
+		t.paneColumns != nil || t.transposed || t.numColumns <= 1 {
+		return nil
+	}
+
+	lenColumnPadding := text.StringWidthWithoutEscSequences(t.style.Box.PaddingLeft + t.style.Box.PaddingRight)
+	lenSeparator := 0
+	if t.style.Options.SeparateColumns {
+		lenSeparator = text.StringWidthWithoutEscSequences(t.style.Box.MiddleSeparator)
+	}
+	getColumnLength := func(colIdx int) int {
+		return t.maxColumnLengths[colIdx] + lenColumnPadding + lenSeparator
+	}
+
+	// the length of a pane without any columns in it, but with the borders, the
+	// auto-index column and the index column
+	lenPaneBase := t.maxRowLength + lenSeparator
+	for colIdx := range t.maxColumnLengths {
+		lenPaneBase -= getColumnLength(colIdx)
+	}
+	colIdxIndex := -1
+	for colIdx := range t.maxColumnLengths {
+		if t.isIndexColumn(colIdx, renderHint{}) {
+			colIdxIndex = colIdx
+			lenPaneBase += getColumnLength(colIdx)
+		}
+	}
+
+	var panes []map[int]bool
+	var pane map[int]bool
+	lenPane := lenPaneBase
+	for colIdx := range t.maxColumnLengths {
+		if colIdx == colIdxIndex {
+			continue
+		}
+		if pane == nil || lenPane+getColumnLength(colIdx) > widthMax {
+			pane = make(map[int]bool)
+			if colIdxIndex >= 0 {
+				pane[t.getRawColumnIndex(colIdxIndex)] = true
+			}
+			panes = append(panes, pane)
+			lenPane = lenPaneBase
+		}
+		pane[t.getRawColumnIndex(colIdx)] = true
+		lenPane += getColumnLength(colIdx)
+	}
+	return panes
+}
+
+// initForRenderPaneColumns hides all the columns not in the pane being
+// rendered when splitting a wide Table into panes.
+func (t *Table) initForRenderPaneColumns() {
+	if t.paneColumns == nil {
+		return
+	}
+	for colIdx := 0; colIdx < t.numColumns; colIdx++ {
+		if !t.paneColumns[colIdx] {
+			cc := t.columnConfigMap[colIdx]
+			cc.Hidden = true
+			t.columnConfigMap[colIdx] = cc
+		}
+	}
+}
+
+// renderSplitWide renders each pane as a Table of its own, and stacks them one
+// below the other separated like pages. If the Table is paged, all the panes
+// of a page are rendered before moving on to the next page.
+func (t *Table) renderSplitWide(panes []map[int]bool) string {
+	// use a temporary page separator for splitting up the pages of each pane
+	pageSeparator := t.style.Box.PageSeparator
+	tempPageSep := fmt.Sprintf("%p // pane page separator // %d", t.rows, time.Now().UnixNano())
+
+	// backup
+	origOutputMirror := t.outputMirror
+	// restore on exit
+	defer func() {
+		t.outputMirror = origOutputMirror
+		t.paneColumns = nil
+		t.style.Box.PageSeparator = pageSeparator
+	}()
+	// override
+	t.outputMirror = nil
+	t.style.Box.PageSeparator = tempPageSep
+
+	var pagesOfPanes [][]string
+	for _, pane := range panes {
+		t.paneColumns = pane
+		for pageIdx, page := range splitPages(t.Render(), tempPageSep) {
+			if pageIdx >= len(pagesOfPanes) {
+				pagesOfPanes = append(pagesOfPanes, nil)
+			}
+			pagesOfPanes[pageIdx] = append(pagesOfPanes[pageIdx], page)
+		}
+	}
+
+	var out strings.Builder
+	for _, pageOfPanes := range pagesOfPanes {
+		for _, page := range pageOfPanes {
+			if out.Len() > 0 {
+				out.WriteString(pageSeparator)
+				out.WriteRune('\n')
+			}
+			out.WriteString(page)
+		}
+	}
+	t.outputMirror = origOutputMirror
+	return t.render(&out)
+}
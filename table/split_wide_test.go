@@ -0,0 +1,165 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTable_Render_SplitWide(t *testing.T) {
+	newTable := func() Writer {
+		tw := NewWriter()
+		tw.AppendHeader(Row{"Name", "Q1", "Q2", "Q3", "Q4", "Region", "Manager"})
+		tw.AppendRows([]Row{
+			{"Arya", 1000, 1100, 1200, 1300, "North", "Eddard Stark"},
+			{"Jon", 2000, 2100, 2200, 2300, "The Wall", "Jeor Mormont"},
+			{"Tyrion", 3000, 3100, 3200, 3300, "Westerlands", "Tywin Lannister"},
+		})
+		tw.AppendFooter(Row{"Total", 6000, 6300, 6600, 6900, "", ""})
+		tw.SetIndexColumn(1)
+		tw.Style().Size.SplitWide = true
+		tw.Style().Size.WidthMax = 40
+		return tw
+	}
+
+	t.Run("default", func(t *testing.T) {
+		tw := newTable()
+
+		compareOutput(t, tw.Render(), `
++--------+------+------+------+------+
+| NAME   |   Q1 |   Q2 |   Q3 |   Q4 |
++--------+------+------+------+------+
+| Arya   | 1000 | 1100 | 1200 | 1300 |
+| Jon    | 2000 | 2100 | 2200 | 2300 |
+| Tyrion | 3000 | 3100 | 3200 | 3300 |
++--------+------+------+------+------+
+| TOTAL  | 6000 | 6300 | 6600 | 6900 |
++--------+------+------+------+------+
+
++--------+-------------+
+| NAME   | REGION      |
++--------+-------------+
+| Arya   | North       |
+| Jon    | The Wall    |
+| Tyrion | Westerlands |
++--------+-------------+
+| TOTAL  |             |
++--------+-------------+
+
++--------+-----------------+
+| NAME   | MANAGER         |
++--------+-----------------+
+| Arya   | Eddard Stark    |
+| Jon    | Jeor Mormont    |
+| Tyrion | Tywin Lannister |
++--------+-----------------+
+| TOTAL  |                 |
++--------+-----------------+`)
+	})
+
+	t.Run("auto-index and title", func(t *testing.T) {
+		tw := newTable()
+		tw.SetAutoIndex(true)
+		tw.SetIndexColumn(0)
+		tw.SetTitle("Sales")
+
+		compareOutput(t, tw.Render(), `
++---------------------------------+
+| Sales                           |
++---+--------+------+------+------+
+|   | NAME   |   Q1 |   Q2 |   Q3 |
++---+--------+------+------+------+
+| 1 | Arya   | 1000 | 1100 | 1200 |
+| 2 | Jon    | 2000 | 2100 | 2200 |
+| 3 | Tyrion | 3000 | 3100 | 3200 |
++---+--------+------+------+------+
+|   | TOTAL  | 6000 | 6300 | 6600 |
++---+--------+------+------+------+
+
++------------------------+
+| Sales                  |
++---+------+-------------+
+|   |   Q4 | REGION      |
++---+------+-------------+
+| 1 | 1300 | North       |
+| 2 | 2300 | The Wall    |
+| 3 | 3300 | Westerlands |
++---+------+-------------+
+|   | 6900 |             |
++---+------+-------------+
+
++---------------------+
+| Sales               |
++---+-----------------+
+|   | MANAGER         |
++---+-----------------+
+| 1 | Eddard Stark    |
+| 2 | Jeor Mormont    |
+| 3 | Tywin Lannister |
++---+-----------------+
+|   |                 |
++---+-----------------+`)
+	})
+
+	t.Run("fits", func(t *testing.T) {
+		tw := newTable()
+		tw.Style().Size.WidthMax = 100
+
+		assert.Equal(t, 8, strings.Count(tw.Render(), "\n"))
+	})
+
+	t.Run("pager", func(t *testing.T) {
+		tw := newTable()
+
+		pager := tw.Pager(PageSize(2))
+		compareOutput(t, pager.Render(), `
++--------+------+------+------+------+
+| NAME   |   Q1 |   Q2 |   Q3 |   Q4 |
++--------+------+------+------+------+
+| Arya   | 1000 | 1100 | 1200 | 1300 |
+| Jon    | 2000 | 2100 | 2200 | 2300 |
++--------+------+------+------+------+
+| TOTAL  | 6000 | 6300 | 6600 | 6900 |
++--------+------+------+------+------+`)
+		compareOutput(t, pager.Next(), `
++--------+-------------+
+| NAME   | REGION      |
++--------+-------------+
+| Arya   | North       |
+| Jon    | The Wall    |
++--------+-------------+
+| TOTAL  |             |
++--------+-------------+`)
+		compareOutput(t, pager.Next(), `
++--------+-----------------+
+| NAME   | MANAGER         |
++--------+-----------------+
+| Arya   | Eddard Stark    |
+| Jon    | Jeor Mormont    |
++--------+-----------------+
+| TOTAL  |                 |
++--------+-----------------+`)
+		compareOutput(t, pager.Next(), `
++--------+------+------+------+------+
+| NAME   |   Q1 |   Q2 |   Q3 |   Q4 |
++--------+------+------+------+------+
+| Tyrion | 3000 | 3100 | 3200 | 3300 |
++--------+------+------+------+------+
+| TOTAL  | 6000 | 6300 | 6600 | 6900 |
++--------+------+------+------+------+`)
+	})
+
+	t.Run("pager with title", func(t *testing.T) {
+		tw := newTable()
+		tw.SetTitle("Sales")
+
+		pager := tw.Pager(PageSize(3))
+		assert.Equal(t, 3, pager.TotalPages())
+		for pageNum := 1; pageNum <= pager.TotalPages(); pageNum++ {
+			page := pager.GoTo(pageNum)
+			assert.True(t, strings.HasPrefix(page, "+--"), page)
+			assert.Contains(t, page, "| Sales")
+		}
+	})
+}
@@ -0,0 +1,306 @@
+package table
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/tinybit/go-pretty/v6/text"
+)
+
+var (
+	// ErrStreamClosed is returned when rows are appended to a closed Stream.
+	ErrStreamClosed = errors.New("table: stream already closed")
+	// ErrStreamHeaderAfterRows is returned when a header is appended after
+	// rows have been written out.
+	ErrStreamHeaderAfterRows = errors.New("table: stream header appended after rows")
+)
+
+// StreamWriter declares the interfaces that can be used to render a table
+// row-by-row to an io.Writer without buffering all the rows in memory.
+type StreamWriter interface {
+	AppendHeader(row Row) error
+	AppendRow(row Row) error
+	Close() error
+	SetSampleSize(numRows int)
+	SetStyle(style Style)
+	Style() *Style
+}
+
+// Stream renders a Table to an io.Writer as the rows arrive. The widths of
+// the columns are fixed once the first row is written out: they are taken
+// from ColumnConfig.WidthMax (or WidthMin) when declared, and sampled from the
+// header and the first few rows otherwise. Content that does not fit is
+// wrapped or truncated using ColumnConfig.WidthMaxEnforcer.
+//
+// Sorting, filtering, footers and auto-merging are not supported as they
+// need all the rows to be known ahead of time.
+type Stream struct {
+	// closed is set once Close has been called
+	closed bool
+	// columnConfigs stores the configs provided by the user
+	columnConfigs []ColumnConfig
+	// err stores the first error returned by the io.Writer
+	err error
+	// header stores the header row
+	header Row
+	// numColumns stores the number of columns fixed at the time of sampling
+	numColumns int
+	// numRowsWritten stores the number of rows written out till now
+	numRowsWritten int
+	// out is the io.Writer to write the rendered content to
+	out io.Writer
+	// sample stores the rows buffered to determine the column widths
+	sample []Row
+	// sampleSize is the number of rows to buffer before fixing the widths
+	sampleSize int
+	// table is used to render each chunk of rows
+	table *Table
+	// topWritten tells if the top border and the header have been written
+	topWritten bool
+	// widthsFixed tells if the column widths have been determined
+	widthsFixed bool
+}
+
+// NewStreamWriter initializes and returns a StreamWriter that writes to w
+// using the given column configurations.
+func NewStreamWriter(w io.Writer, cols []ColumnConfig) StreamWriter {
+	return &Stream{
+		columnConfigs: cols,
+		out:           w,
+		sampleSize:    1,
+		table:         &Table{},
+	}
+}
+
+// AppendHeader sets the header row. It has to be called before any row gets
+// written out.
+func (s *Stream) AppendHeader(row Row) error {
+	if s.closed {
+		return ErrStreamClosed
+	}
+	if s.widthsFixed {
+		return ErrStreamHeaderAfterRows
+	}
+	s.header = row
+	return nil
+}
+
+// AppendRow renders the row and writes it out, unless the Stream is still
+// sampling rows to determine the column widths.
+func (s *Stream) AppendRow(row Row) error {
+	if s.closed {
+		return ErrStreamClosed
+	}
+	if !s.widthsFixed {
+		s.sample = append(s.sample, row)
+		if len(s.sample) < s.sampleSize {
+			return s.err
+		}
+		s.flushSample()
+		return s.err
+	}
+	s.writeRows([]Row{row})
+	return s.err
+}
+
+// Close writes out any buffered rows and the bottom border. The Stream cannot
+// be used after this.
+func (s *Stream) Close() error {
+	if s.closed {
+		return ErrStreamClosed
+	}
+	if !s.widthsFixed {
+		s.flushSample()
+	}
+	if s.topWritten {
+		var out strings.Builder
+		s.table.renderRowsBorderBottom(&out)
+		s.write(&out)
+	}
+	s.closed = true
+	return s.err
+}
+
+// SetSampleSize sets the number of rows to buffer before determining the
+// widths of the columns that have no WidthMax/WidthMin declared. Nothing
+// gets written out until these many rows are appended (or Close is called).
+// The default is 1.
+func (s *Stream) SetSampleSize(numRows int) {
+	if numRows < 1 {
+		numRows = 1
+	}
+	s.sampleSize = numRows
+}
+
+// SetStyle overrides the DefaultStyle with the provided one.
+func (s *Stream) SetStyle(style Style) {
+	s.table.SetStyle(style)
+}
+
+// Style returns the current style.
+func (s *Stream) Style() *Style {
+	return s.table.Style()
+}
+
+// fixColumnWidths determines the width of every column from the declared
+// widths or the sampled rows, and locks in the alignment of the columns so
+// that it doesn't vary from row to row.
+func (s *Stream) fixColumnWidths() {
+	t := s.table
+	t.rowsHeaderRaw = nil
+	if s.header != nil {
+		t.rowsHeaderRaw = []Row{s.header}
+	}
+	t.columnConfigs = s.columnConfigs
+	t.Style()
+	t.initForRenderColumnConfigs()
+
+	numColumns := len(s.header)
+	for _, row := range s.sample {
+		if len(row) > numColumns {
+			numColumns = len(row)
+		}
+	}
+	for colIdx := range t.columnConfigMap {
+		if colIdx+1 > numColumns {
+			numColumns = colIdx + 1
+		}
+	}
+
+	widths := make([]int, numColumns)
+	isNonNumeric := make([]bool, numColumns)
+	for colIdx, col := range s.header {
+		colStr := t.analyzeAndStringifyColumn(colIdx, col, renderHint{isHeaderRow: true})
+		widths[colIdx] = text.LongestLineLen(colStr)
+	}
+	for _, row := range s.sample {
+		for colIdx, col := range row {
+			if !isNumber(col) {
+				isNonNumeric[colIdx] = true
+			}
+			colStr := t.analyzeAndStringifyColumn(colIdx, col, renderHint{})
+			if colLen := text.LongestLineLen(colStr); colLen > widths[colIdx] {
+				widths[colIdx] = colLen
+			}
+		}
+	}
+
+	configs := make([]ColumnConfig, numColumns)
+	for colIdx := range configs {
+		cfg, ok := t.columnConfigMap[colIdx]
+		if !ok {
+			cfg = ColumnConfig{}
+		}
+		cfg.Number = colIdx + 1
+		if cfg.WidthMax > 0 {
+			widths[colIdx] = cfg.WidthMax
+		} else if cfg.WidthMin > 0 {
+			widths[colIdx] = cfg.WidthMin
+		}
+		if widths[colIdx] == 0 {
+			widths[colIdx] = 1
+		}
+		cfg.WidthMax, cfg.WidthMin = widths[colIdx], widths[colIdx]
+		if cfg.Align == text.AlignDefault {
+			if !isNonNumeric[colIdx] && len(s.sample) > 0 {
+				cfg.Align = text.AlignRight
+			} else if t.style.Format.RowAlign != text.AlignDefault {
+				cfg.Align = t.style.Format.RowAlign
+			} else {
+				cfg.Align = text.AlignLeft
+			}
+		}
+		cfg.AutoMerge = false
+		configs[colIdx] = cfg
+	}
+	t.columnConfigs = configs
+	s.numColumns = numColumns
+	s.widthsFixed = true
+	if s.header != nil {
+		t.rowsHeaderRaw = []Row{s.fitRow(s.header)}
+	}
+}
+
+// fitRow pads short rows so that every column gets drawn, and drops the cells
+// of long rows that have no column to go into.
+func (s *Stream) fitRow(row Row) Row {
+	if len(row) > s.numColumns {
+		return row[:s.numColumns]
+	} else if len(row) < s.numColumns {
+		rowPadded := make(Row, s.numColumns)
+		copy(rowPadded, row)
+		for colIdx := len(row); colIdx < s.numColumns; colIdx++ {
+			rowPadded[colIdx] = ""
+		}
+		return rowPadded
+	}
+	return row
+}
+
+func (s *Stream) flushSample() {
+	s.fixColumnWidths()
+	s.writeRows(s.sample)
+	s.sample = nil
+}
+
+// initForRender prepares the Table to render the current chunk of rows. As a
+// chunk may not need all the separators that the full table would, all of
+// them are generated here.
+func (s *Stream) initForRender() {
+	t := s.table
+	t.initForRender(renderModeDefault)
+
+	paddingLength := text.StringWidthWithoutEscSequences(t.style.Box.PaddingLeft + t.style.Box.PaddingRight)
+	for st := separatorType(0); st < separatorTypeCount; st++ {
+		separator := t.style.Box.middleHorizontal(st)
+		t.rowSeparatorStrings[st] = separator
+		if _, ok := t.rowSeparators[separator]; !ok {
+			t.rowSeparators[separator] = make(rowStr, t.numColumns)
+			for colIdx, maxColumnLength := range t.maxColumnLengths {
+				t.rowSeparators[separator][colIdx] = text.RepeatAndTrim(separator, maxColumnLength+paddingLength)
+			}
+		}
+	}
+}
+
+func (s *Stream) write(out *strings.Builder) {
+	if s.err != nil || out.Len() == 0 {
+		return
+	}
+	outStr := out.String() + "\n"
+	_, s.err = s.out.Write([]byte(outStr))
+}
+
+func (s *Stream) writeRows(rows []Row) {
+	t := s.table
+	t.rowsCellSpans, t.rowsRaw, t.rowsRawFiltered = nil, nil, nil
+	for _, row := range rows {
+		t.AppendRow(s.fitRow(row))
+	}
+	s.initForRender()
+	if t.numColumns == 0 {
+		return
+	}
+
+	var out strings.Builder
+	if !s.topWritten {
+		t.renderRowsBorderTop(&out)
+		t.renderRowsHeader(&out)
+		s.topWritten = true
+	}
+	for _, row := range t.rows {
+		if s.numRowsWritten > 0 && t.style.Options.SeparateRows {
+			t.renderRowSeparator(&out, renderHint{
+				rowNumber:     s.numRowsWritten,
+				separatorType: separatorTypeRowMiddle,
+			})
+		}
+		s.numRowsWritten++
+		t.renderRow(&out, row, renderHint{
+			isFirstRow: s.numRowsWritten == 1,
+			rowNumber:  s.numRowsWritten,
+		})
+	}
+	s.write(&out)
+}
@@ -0,0 +1,71 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tinybit/go-pretty/v6/text"
+)
+
+func TestStream(t *testing.T) {
+	var out strings.Builder
+	sw := NewStreamWriter(&out, []ColumnConfig{
+		{Name: "Last Name", WidthMax: 6, WidthMaxEnforcer: text.Trim},
+	})
+	sw.SetSampleSize(3)
+	assert.Nil(t, sw.AppendHeader(testHeader))
+	assert.Nil(t, sw.AppendRow(testRows[0]))
+	assert.Nil(t, sw.AppendRow(testRows[1]))
+	assert.Empty(t, out.String())
+	assert.Nil(t, sw.AppendRow(testRows[2]))
+	compareOutput(t, out.String(), `
++-----+------------+--------+--------+-----------------------------+
+|   # | FIRST NAME | LAST N | SALARY |                             |
++-----+------------+--------+--------+-----------------------------+
+|   1 | Arya       | Stark  |   3000 |                             |
+|  20 | Jon        | Snow   |   2000 | You know nothing, Jon Snow! |
+| 300 | Tyrion     | Lannis |   5000 |                             |
+`)
+
+	out.Reset()
+	assert.Equal(t, ErrStreamHeaderAfterRows, sw.AppendHeader(testHeader))
+	assert.Nil(t, sw.AppendRow(Row{4, "Daenerys Stormborn", "Targaryen"}))
+	compareOutput(t, out.String(), `
+|   4 | Daenerys S | Targar |        |                             |
+|     | tormborn   |        |        |                             |
+`)
+
+	out.Reset()
+	assert.Nil(t, sw.Close())
+	compareOutput(t, out.String(), `
++-----+------------+--------+--------+-----------------------------+
+`)
+	assert.Equal(t, ErrStreamClosed, sw.Close())
+	assert.Equal(t, ErrStreamClosed, sw.AppendRow(testRows[0]))
+}
+
+func TestStream_NoHeader(t *testing.T) {
+	var out strings.Builder
+	sw := NewStreamWriter(&out, []ColumnConfig{{Number: 2, WidthMax: 10}})
+	sw.SetStyle(StyleLight)
+	sw.Style().Options.SeparateRows = true
+	assert.Nil(t, sw.AppendRow(Row{1, "Arya"}))
+	assert.Nil(t, sw.AppendRow(Row{2, "Jon"}))
+	assert.Nil(t, sw.Close())
+
+	compareOutput(t, out.String(), `
+┌───┬────────────┐
+│ 1 │ Arya       │
+├───┼────────────┤
+│ 2 │ Jon        │
+└───┴────────────┘
+`)
+}
+
+func TestStream_Empty(t *testing.T) {
+	var out strings.Builder
+	sw := NewStreamWriter(&out, nil)
+	assert.Nil(t, sw.Close())
+	assert.Empty(t, out.String())
+}
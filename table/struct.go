@@ -0,0 +1,267 @@
+package table
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tinybit/go-pretty/v6/text"
+)
+
+// StructTag is the name of the struct field tag looked up by AppendStructs.
+const StructTag = "pretty"
+
+// StructTransformers maps the names usable in the "transformer=" option of a
+// struct field tag to the Transformer to use. Add to this map to make custom
+// Transformers available to AppendStructs.
+var StructTransformers = map[string]text.Transformer{
+	"json":     text.NewJSONTransformer("", "  "),
+	"number":   text.NewNumberTransformer("%v"),
+	"time":     text.NewTimeTransformer(time.RFC3339, nil),
+	"unixtime": text.NewUnixTimeTransformer(time.RFC3339, nil),
+	"url":      text.NewURLTransformer(),
+}
+
+var (
+	typeStringer = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+	typeTime     = reflect.TypeOf(time.Time{})
+)
+
+// structField describes a (possibly nested) field of a struct that gets
+// rendered as a column.
+type structField struct {
+	config    ColumnConfig
+	hasConfig bool
+	index     []int
+	name      string
+}
+
+// AppendStructs appends the elements of a slice (or array) of structs as rows.
+// Every exported field becomes a column, with nested structs being flattened
+// into "Parent.Child" columns and the fields of embedded structs promoted to
+// the parent. A header row is generated from the field names if no header has
+// been appended yet.
+//
+// Fields can be customized using a "pretty" tag like below:
+//
+//	type Employee struct {
+//		ID     int     `pretty:"#"`
+//		Name   string  `pretty:"Name,align=center"`
+//		Salary float64 `pretty:",align=right,transformer=number"`
+//		SSN    string  `pretty:"-"`
+//		Team   string  `pretty:",hidden"`
+//	}
+//
+// Supported options are:
+//   - align=left|center|justify|right
+//   - hidden
+//   - transformer=<name from StructTransformers>
+//   - width=<number> (sets ColumnConfig.WidthMax)
+//
+// Column configurations are generated for the fields with options the first
+// time they are seen, and get appended to the ones set using SetColumnConfigs.
+// They are tied to the column names if a header has been appended already.
+//
+// Returns false if the input is not a non-empty slice of structs.
+func (t *Table) AppendStructs(slice interface{}) bool {
+	items := objAsSlice(slice)
+	if len(items) == 0 {
+		return false
+	}
+
+	var fields []structField
+	var fieldsType reflect.Type
+	for _, item := range items {
+		val := reflect.ValueOf(item)
+		for val.Kind() == reflect.Ptr && !val.IsNil() {
+			val = val.Elem()
+		}
+		if val.Kind() != reflect.Struct {
+			continue
+		}
+		if fields == nil {
+			fields, fieldsType = structFields(val.Type(), "", nil, nil), val.Type()
+			t.appendStructsHeaderAndConfigs(fields)
+		} else if val.Type() != fieldsType {
+			continue
+		}
+
+		row := make(Row, len(fields))
+		for colIdx, field := range fields {
+			row[colIdx] = structFieldValue(val, field.index)
+		}
+		t.AppendRow(row)
+	}
+	return fields != nil
+}
+
+func (t *Table) appendStructsHeaderAndConfigs(fields []structField) {
+	hasHeader := len(t.rowsHeaderRaw) > 0
+	if !hasHeader {
+		header := make(Row, len(fields))
+		for colIdx, field := range fields {
+			header[colIdx] = field.name
+		}
+		t.AppendHeader(header)
+	}
+
+	// generate the configs only once per column, so that appending in batches
+	// does not duplicate them
+	if t.structColumnConfigs == nil {
+		t.structColumnConfigs = make(map[string]bool)
+	}
+	for colIdx, field := range fields {
+		if field.hasConfig && !t.structColumnConfigs[field.name] {
+			cfg := field.config
+			cfg.Name = field.name
+			if !hasHeader {
+				// the generated header is known to be in the field order
+				cfg.Number = colIdx + 1
+			}
+			t.columnConfigs = append(t.columnConfigs, cfg)
+			t.structColumnConfigs[field.name] = true
+		}
+	}
+}
+
+// structFields returns the list of fields to render for the given struct type.
+// The struct types being flattened on the way down (path) are not flattened
+// again, so that a self-referential type renders the field referring back as
+// a single column instead of recursing forever.
+func structFields(typ reflect.Type, prefix string, index []int, path []reflect.Type) []structField {
+	path = append(append([]reflect.Type{}, path...), typ)
+	var fields []structField
+	for idx := 0; idx < typ.NumField(); idx++ {
+		f := typ.Field(idx)
+		tag := f.Tag.Get(StructTag)
+		if tag == "-" {
+			continue
+		}
+		fieldType := f.Type
+		for fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		// unexported fields are skipped, except for embedded structs which
+		// may have exported fields that get promoted
+		isEmbeddedStruct := f.Anonymous && fieldType.Kind() == reflect.Struct
+		if !f.IsExported() && !isEmbeddedStruct {
+			continue
+		}
+
+		fieldIndex := append(append([]int{}, index...), idx)
+		name, cfg, hasConfig := parseStructTag(tag)
+		isPromoted := f.Anonymous && name == ""
+		if name == "" {
+			name = f.Name
+		}
+		if structIsFlattenable(fieldType) && !structIsOnPath(fieldType, path) {
+			if isPromoted {
+				fields = append(fields, structFields(fieldType, prefix, fieldIndex, path)...)
+			} else {
+				fields = append(fields, structFields(fieldType, prefix+name+".", fieldIndex, path)...)
+			}
+			continue
+		}
+		fields = append(fields, structField{
+			config:    cfg,
+			hasConfig: hasConfig,
+			index:     fieldIndex,
+			name:      prefix + name,
+		})
+	}
+	return fields
+}
+
+// structFieldValue walks down the given field index and returns the value of
+// the field; nil pointers on the way result in an empty value.
+func structFieldValue(val reflect.Value, index []int) interface{} {
+	for _, idx := range index {
+		for val.Kind() == reflect.Ptr {
+			if val.IsNil() {
+				return ""
+			}
+			val = val.Elem()
+		}
+		val = val.Field(idx)
+	}
+	for val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			return ""
+		}
+		val = val.Elem()
+	}
+	if !val.CanInterface() {
+		return ""
+	}
+	return val.Interface()
+}
+
+// structIsFlattenable returns true for struct types whose fields should be
+// rendered as individual columns, as opposed to types like time.Time which
+// know how to print themselves.
+func structIsFlattenable(typ reflect.Type) bool {
+	if typ.Kind() != reflect.Struct || typ == typeTime {
+		return false
+	}
+	return !typ.Implements(typeStringer) && !reflect.PtrTo(typ).Implements(typeStringer)
+}
+
+// structIsOnPath returns true if the given struct type is one of the types
+// being flattened already.
+func structIsOnPath(typ reflect.Type, path []reflect.Type) bool {
+	for _, pathType := range path {
+		if pathType == typ {
+			return true
+		}
+	}
+	return false
+}
+
+func parseStructTag(tag string) (string, ColumnConfig, bool) {
+	var cfg ColumnConfig
+	hasConfig := false
+	parts := strings.Split(tag, ",")
+	for _, option := range parts[1:] {
+		key, value := option, ""
+		if sepIdx := strings.Index(option, "="); sepIdx >= 0 {
+			key, value = option[:sepIdx], option[sepIdx+1:]
+		}
+		switch strings.TrimSpace(key) {
+		case "align":
+			cfg.Align = parseStructTagAlign(value)
+			hasConfig = true
+		case "hidden":
+			cfg.Hidden = true
+			hasConfig = true
+		case "transformer":
+			if transformer, ok := StructTransformers[value]; ok {
+				cfg.Transformer = transformer
+				hasConfig = true
+			}
+		case "width":
+			if width, err := strconv.Atoi(value); err == nil && width > 0 {
+				cfg.WidthMax = width
+				hasConfig = true
+			}
+		}
+	}
+	return strings.TrimSpace(parts[0]), cfg, hasConfig
+}
+
+func parseStructTagAlign(value string) text.Align {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "left":
+		return text.AlignLeft
+	case "center":
+		return text.AlignCenter
+	case "justify":
+		return text.AlignJustify
+	case "right":
+		return text.AlignRight
+	case "auto":
+		return text.AlignAuto
+	}
+	return text.AlignDefault
+}
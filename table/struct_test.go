@@ -0,0 +1,142 @@
+package table
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testStructAddress struct {
+	City    string
+	Country string `pretty:"Nation"`
+}
+
+type testStructAudit struct {
+	Created time.Time `pretty:",transformer=time"`
+	secret  string
+}
+
+type testStructLevel int
+
+func (l testStructLevel) String() string {
+	return fmt.Sprintf("L%d", int(l))
+}
+
+type testStructNode struct {
+	Name string
+	Next *testStructNode
+}
+
+type testStructList struct {
+	Head testStructNode
+	Size int
+}
+
+type testStructEmployee struct {
+	testStructAudit
+	ID      int    `pretty:"#"`
+	Name    string `pretty:"Name,align=center"`
+	Salary  int    `pretty:",align=right,width=6"`
+	Level   testStructLevel
+	Address *testStructAddress
+	SSN     string `pretty:"-"`
+	Team    string `pretty:",hidden"`
+	private string
+}
+
+func TestTable_AppendStructs(t *testing.T) {
+	created := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+	tw := NewWriter()
+	assert.True(t, tw.AppendStructs([]*testStructEmployee{
+		{
+			testStructAudit: testStructAudit{Created: created},
+			ID:              1, Name: "Arya", Salary: 3000, Level: 2,
+			Address: &testStructAddress{City: "Winterfell", Country: "North"},
+			SSN:     "123", Team: "Stark",
+		},
+		{
+			testStructAudit: testStructAudit{Created: created},
+			ID:              20, Name: "Jon", Salary: 2000, Level: 3,
+			Team: "Night's Watch",
+		},
+		nil,
+	}))
+	assert.Equal(t, 2, tw.Length())
+
+	compareOutput(t, tw.Render(), `
++----------------------+----+------+--------+-------+--------------+----------------+
+| CREATED              |  # | NAME | SALARY | LEVEL | ADDRESS.CITY | ADDRESS.NATION |
++----------------------+----+------+--------+-------+--------------+----------------+
+| 2021-01-02T03:04:05Z |  1 | Arya |   3000 |    L2 | Winterfell   | North          |
+| 2021-01-02T03:04:05Z | 20 |  Jon |   2000 |    L3 |              |                |
++----------------------+----+------+--------+-------+--------------+----------------+`)
+}
+
+func TestTable_AppendStructs_ExistingHeader(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"City", "Country"})
+	assert.True(t, tw.AppendStructs([]testStructAddress{{City: "Winterfell", Country: "North"}}))
+
+	compareOutput(t, tw.Render(), `
++------------+---------+
+| CITY       | COUNTRY |
++------------+---------+
+| Winterfell | North   |
++------------+---------+`)
+}
+
+func TestTable_AppendStructs_Batches(t *testing.T) {
+	tw := NewWriter()
+	assert.True(t, tw.AppendStructs([]testStructEmployee{{ID: 1, Name: "Arya", Salary: 3000}}))
+	numColumnConfigs := len(tw.(*Table).columnConfigs)
+	assert.True(t, tw.AppendStructs([]testStructEmployee{{ID: 20, Name: "Jon", Salary: 2000}}))
+	assert.Len(t, tw.(*Table).columnConfigs, numColumnConfigs)
+	assert.Len(t, tw.(*Table).rowsHeaderRaw, 1)
+	assert.Equal(t, 2, tw.Length())
+}
+
+func TestTable_AppendStructs_ExistingHeaderWithConfigs(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"#", "Name"})
+	assert.True(t, tw.AppendStructs([]struct {
+		ID   int    `pretty:"#,hidden"`
+		Name string `pretty:"Name,align=right"`
+	}{{ID: 1, Name: "Arya"}, {ID: 2, Name: "Jon"}}))
+
+	for _, cfg := range tw.(*Table).columnConfigs {
+		assert.Zero(t, cfg.Number, cfg.Name)
+	}
+	compareOutput(t, tw.Render(), `
++------+
+| NAME |
++------+
+| Arya |
+|  Jon |
++------+`)
+}
+
+func TestTable_AppendStructs_Invalid(t *testing.T) {
+	tw := NewWriter()
+	assert.False(t, tw.AppendStructs(nil))
+	assert.False(t, tw.AppendStructs(testStructAddress{}))
+	assert.False(t, tw.AppendStructs([]int{1, 2, 3}))
+	assert.False(t, tw.AppendStructs([]testStructAddress{}))
+	assert.Equal(t, 0, tw.Length())
+}
+
+func TestTable_AppendStructs_SelfReferential(t *testing.T) {
+	tw := NewWriter()
+	assert.True(t, tw.AppendStructs([]testStructList{
+		{Head: testStructNode{Name: "a", Next: &testStructNode{Name: "b"}}, Size: 2},
+		{Head: testStructNode{Name: "c"}, Size: 1},
+	}))
+	compareOutput(t, tw.Render(), `
++-----------+-----------+------+
+| HEAD.NAME | HEAD.NEXT | SIZE |
++-----------+-----------+------+
+| a         | {b <nil>} |    2 |
+| c         |           |    1 |
++-----------+-----------+------+`)
+}
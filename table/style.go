@@ -8,6 +8,7 @@ type Style struct {
 	Color   ColorOptions  // colors to use for the rows and columns
 	Format  FormatOptions // formatting options for the rows and columns
 	HTML    HTMLOptions   // rendering options for HTML mode
+	LaTeX   LaTeXOptions  // rendering options for LaTeX mode
 	Options Options       // misc. options for the table
 	Size    SizeOptions   // size (width) options for the table
 	Title   TitleOptions  // formation options for the title text
@@ -30,6 +31,7 @@ var (
 		Color:   ColorOptionsDefault,
 		Format:  FormatOptionsDefault,
 		HTML:    DefaultHTMLOptions,
+		LaTeX:   LaTeXOptionsDefault,
 		Options: OptionsDefault,
 		Size:    SizeOptionsDefault,
 		Title:   TitleOptionsDefault,
@@ -51,6 +53,7 @@ var (
 		Color:   ColorOptionsDefault,
 		Format:  FormatOptionsDefault,
 		HTML:    DefaultHTMLOptions,
+		LaTeX:   LaTeXOptionsDefault,
 		Options: OptionsDefault,
 		Size:    SizeOptionsDefault,
 		Title:   TitleOptionsDefault,
@@ -65,6 +68,7 @@ var (
 		Color:   ColorOptionsBright,
 		Format:  FormatOptionsDefault,
 		HTML:    DefaultHTMLOptions,
+		LaTeX:   LaTeXOptionsDefault,
 		Options: OptionsNoBordersAndSeparators,
 		Size:    SizeOptionsDefault,
 		Title:   TitleOptionsDark,
@@ -79,6 +83,7 @@ var (
 		Color:   ColorOptionsDark,
 		Format:  FormatOptionsDefault,
 		HTML:    DefaultHTMLOptions,
+		LaTeX:   LaTeXOptionsDefault,
 		Options: OptionsNoBordersAndSeparators,
 		Size:    SizeOptionsDefault,
 		Title:   TitleOptionsBright,
@@ -93,6 +98,7 @@ var (
 		Color:   ColorOptionsBlackOnBlueWhite,
 		Format:  FormatOptionsDefault,
 		HTML:    DefaultHTMLOptions,
+		LaTeX:   LaTeXOptionsDefault,
 		Options: OptionsNoBordersAndSeparators,
 		Size:    SizeOptionsDefault,
 		Title:   TitleOptionsBlueOnBlack,
@@ -107,6 +113,7 @@ var (
 		Color:   ColorOptionsBlackOnCyanWhite,
 		Format:  FormatOptionsDefault,
 		HTML:    DefaultHTMLOptions,
+		LaTeX:   LaTeXOptionsDefault,
 		Options: OptionsNoBordersAndSeparators,
 		Size:    SizeOptionsDefault,
 		Title:   TitleOptionsCyanOnBlack,
@@ -121,6 +128,7 @@ var (
 		Color:   ColorOptionsBlackOnGreenWhite,
 		Format:  FormatOptionsDefault,
 		HTML:    DefaultHTMLOptions,
+		LaTeX:   LaTeXOptionsDefault,
 		Options: OptionsNoBordersAndSeparators,
 		Size:    SizeOptionsDefault,
 		Title:   TitleOptionsGreenOnBlack,
@@ -135,6 +143,7 @@ var (
 		Color:   ColorOptionsBlackOnMagentaWhite,
 		Format:  FormatOptionsDefault,
 		HTML:    DefaultHTMLOptions,
+		LaTeX:   LaTeXOptionsDefault,
 		Options: OptionsNoBordersAndSeparators,
 		Size:    SizeOptionsDefault,
 		Title:   TitleOptionsMagentaOnBlack,
@@ -149,6 +158,7 @@ var (
 		Color:   ColorOptionsBlackOnYellowWhite,
 		Format:  FormatOptionsDefault,
 		HTML:    DefaultHTMLOptions,
+		LaTeX:   LaTeXOptionsDefault,
 		Options: OptionsNoBordersAndSeparators,
 		Size:    SizeOptionsDefault,
 		Title:   TitleOptionsYellowOnBlack,
@@ -163,6 +173,7 @@ var (
 		Color:   ColorOptionsBlackOnRedWhite,
 		Format:  FormatOptionsDefault,
 		HTML:    DefaultHTMLOptions,
+		LaTeX:   LaTeXOptionsDefault,
 		Options: OptionsNoBordersAndSeparators,
 		Size:    SizeOptionsDefault,
 		Title:   TitleOptionsRedOnBlack,
@@ -177,6 +188,7 @@ var (
 		Color:   ColorOptionsBlueWhiteOnBlack,
 		Format:  FormatOptionsDefault,
 		HTML:    DefaultHTMLOptions,
+		LaTeX:   LaTeXOptionsDefault,
 		Options: OptionsNoBordersAndSeparators,
 		Size:    SizeOptionsDefault,
 		Title:   TitleOptionsBlackOnBlue,
@@ -191,6 +203,7 @@ var (
 		Color:   ColorOptionsCyanWhiteOnBlack,
 		Format:  FormatOptionsDefault,
 		HTML:    DefaultHTMLOptions,
+		LaTeX:   LaTeXOptionsDefault,
 		Options: OptionsNoBordersAndSeparators,
 		Size:    SizeOptionsDefault,
 		Title:   TitleOptionsBlackOnCyan,
@@ -205,6 +218,7 @@ var (
 		Color:   ColorOptionsGreenWhiteOnBlack,
 		Format:  FormatOptionsDefault,
 		HTML:    DefaultHTMLOptions,
+		LaTeX:   LaTeXOptionsDefault,
 		Options: OptionsNoBordersAndSeparators,
 		Size:    SizeOptionsDefault,
 		Title:   TitleOptionsBlackOnGreen,
@@ -219,6 +233,7 @@ var (
 		Color:   ColorOptionsMagentaWhiteOnBlack,
 		Format:  FormatOptionsDefault,
 		HTML:    DefaultHTMLOptions,
+		LaTeX:   LaTeXOptionsDefault,
 		Options: OptionsNoBordersAndSeparators,
 		Size:    SizeOptionsDefault,
 		Title:   TitleOptionsBlackOnMagenta,
@@ -233,6 +248,7 @@ var (
 		Color:   ColorOptionsRedWhiteOnBlack,
 		Format:  FormatOptionsDefault,
 		HTML:    DefaultHTMLOptions,
+		LaTeX:   LaTeXOptionsDefault,
 		Options: OptionsNoBordersAndSeparators,
 		Size:    SizeOptionsDefault,
 		Title:   TitleOptionsBlackOnRed,
@@ -247,6 +263,7 @@ var (
 		Color:   ColorOptionsYellowWhiteOnBlack,
 		Format:  FormatOptionsDefault,
 		HTML:    DefaultHTMLOptions,
+		LaTeX:   LaTeXOptionsDefault,
 		Options: OptionsNoBordersAndSeparators,
 		Size:    SizeOptionsDefault,
 		Title:   TitleOptionsBlackOnYellow,
@@ -268,6 +285,7 @@ var (
 		Color:   ColorOptionsDefault,
 		Format:  FormatOptionsDefault,
 		HTML:    DefaultHTMLOptions,
+		LaTeX:   LaTeXOptionsDefault,
 		Options: OptionsDefault,
 		Size:    SizeOptionsDefault,
 		Title:   TitleOptionsDefault,
@@ -289,6 +307,7 @@ var (
 		Color:   ColorOptionsDefault,
 		Format:  FormatOptionsDefault,
 		HTML:    DefaultHTMLOptions,
+		LaTeX:   LaTeXOptionsDefault,
 		Options: OptionsDefault,
 		Size:    SizeOptionsDefault,
 		Title:   TitleOptionsDefault,
@@ -310,6 +329,7 @@ var (
 		Color:   ColorOptionsDefault,
 		Format:  FormatOptionsDefault,
 		HTML:    DefaultHTMLOptions,
+		LaTeX:   LaTeXOptionsDefault,
 		Options: OptionsDefault,
 		Size:    SizeOptionsDefault,
 		Title:   TitleOptionsDefault,
@@ -331,6 +351,7 @@ var (
 		Color:   ColorOptionsDefault,
 		Format:  FormatOptionsDefault,
 		HTML:    DefaultHTMLOptions,
+		LaTeX:   LaTeXOptionsDefault,
 		Options: OptionsDefault,
 		Size:    SizeOptionsDefault,
 		Title:   TitleOptionsDefault,
@@ -0,0 +1,15 @@
+package table
+
+// LaTeXOptions defines the global options to control LaTeX rendering.
+type LaTeXOptions struct {
+	LongTable bool   // use the "longtable" environment instead of "tabular"?
+	Placement string // placement specifier for the "table" float (ex.: "htbp")
+}
+
+var (
+	// LaTeXOptionsDefault defines sensible LaTeX rendering defaults.
+	LaTeXOptionsDefault = LaTeXOptions{
+		LongTable: false,
+		Placement: "",
+	}
+)
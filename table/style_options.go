@@ -18,6 +18,33 @@ type Options struct {
 	//       │            │ TOTAL     │  10000 │
 	DrawBorder bool
 
+	// Expanded renders each Row as a block of "<column name> | <value>" lines
+	// below a separator with the Row number, like the expanded display mode
+	// of psql (\x). Example of a table where it is set to ExpandedOn:
+	//  ┌─[ RECORD 1 ]─────────────────────────────┐
+	//  │ #          │ 1                           │
+	//  │ FIRST NAME │ Arya                        │
+	//  │ LAST NAME  │ Stark                       │
+	//  │ SALARY     │ 3000                        │
+	//  │ E          │                             │
+	//  ├─[ RECORD 2 ]─────────────────────────────┤
+	//  │ #          │ 20                          │
+	//  │ FIRST NAME │ Jon                         │
+	//  │ LAST NAME  │ Snow                        │
+	//  │ SALARY     │ 2000                        │
+	//  │ E          │ You know nothing, Jon Snow! │
+	//  ├─[ FOOTER ]─┼─────────────────────────────┤
+	//  │ #          │                             │
+	//  │ FIRST NAME │                             │
+	//  │ LAST NAME  │ TOTAL                       │
+	//  │ SALARY     │ 10000                       │
+	//  │ E          │                             │
+	//  └────────────┴─────────────────────────────┘
+	// The column Transformers, Colors and WidthMax limits are honored, and the
+	// values get wrapped to fit within Size.WidthMax. This applies only to
+	// Render(), and is ignored if Transpose is enabled.
+	Expanded ExpandedMode
+
 	// SeparateColumns enables or disable drawing border between columns.
 	// Example of a table where it is disabled:
 	//  ┌─────────────────────────────────────────────────────────────────┐
@@ -68,27 +95,63 @@ type Options struct {
 	//  │     │            │ TOTAL     │  10000 │                             │
 	//  └─────┴────────────┴───────────┴────────┴─────────────────────────────┘
 	SeparateRows bool
+
+	// Transpose swaps the rows and columns while rendering, with the Header
+	// cells becoming the first column(s), and the Footer cells becoming the
+	// last column(s). Example of a table where it is enabled:
+	//  ┌────────────┬───────┬─────────────────────────────┬───────────┬───────┐
+	//  │ #          │ 1     │ 20                          │ 300       │       │
+	//  │ FIRST NAME │ Arya  │ Jon                         │ Tyrion    │       │
+	//  │ LAST NAME  │ Stark │ Snow                        │ Lannister │ TOTAL │
+	//  │ SALARY     │ 3000  │ 2000                        │ 5000      │ 10000 │
+	//  │            │       │ You know nothing, Jon Snow! │           │       │
+	//  └────────────┴───────┴─────────────────────────────┴───────────┴───────┘
+	// Filtering, sorting and grouping are done before the swap, and the column
+	// Transformers, Colors (including the ColorRules and the Heatmap) and
+	// WidthMax limits are applied to the cells before they move. The alignment of a column (Align, AlignHeader and AlignFooter)
+	// applies to the row it becomes. The rest of the column and row
+	// configurations (merging, etc.) and the Cell spans are ignored. The
+	// JSON/NDJSON outputs ignore this option.
+	Transpose bool
 }
 
+// ExpandedMode defines when to render a Table in the expanded mode.
+type ExpandedMode int
+
+const (
+	// ExpandedOff never renders the Table in the expanded mode.
+	ExpandedOff ExpandedMode = iota
+	// ExpandedOn always renders the Table in the expanded mode.
+	ExpandedOn
+	// ExpandedAuto renders the Table in the expanded mode only if it would
+	// otherwise not fit within Size.WidthMax and get truncated, like psql's
+	// "\x auto".
+	ExpandedAuto
+)
+
 var (
 	// OptionsDefault defines sensible global options.
 	OptionsDefault = Options{
 		DoNotColorBordersAndSeparators: false,
 		DrawBorder:                     true,
+		Expanded:                       ExpandedOff,
 		SeparateColumns:                true,
 		SeparateFooter:                 true,
 		SeparateHeader:                 true,
 		SeparateRows:                   false,
+		Transpose:                      false,
 	}
 
 	// OptionsNoBorders sets up a table without any borders.
 	OptionsNoBorders = Options{
 		DoNotColorBordersAndSeparators: false,
 		DrawBorder:                     false,
+		Expanded:                       ExpandedOff,
 		SeparateColumns:                true,
 		SeparateFooter:                 true,
 		SeparateHeader:                 true,
 		SeparateRows:                   false,
+		Transpose:                      false,
 	}
 
 	// OptionsNoBordersAndSeparators sets up a table without any borders or
@@ -96,9 +159,11 @@ var (
 	OptionsNoBordersAndSeparators = Options{
 		DoNotColorBordersAndSeparators: false,
 		DrawBorder:                     false,
+		Expanded:                       ExpandedOff,
 		SeparateColumns:                false,
 		SeparateFooter:                 false,
 		SeparateHeader:                 false,
 		SeparateRows:                   false,
+		Transpose:                      false,
 	}
 )
@@ -2,9 +2,28 @@ package table
 
 // SizeOptions defines the way to control the width of the table output.
 type SizeOptions struct {
+	// NoteHiddenColumns appends a note like "(3 columns hidden)" to the
+	// caption if any columns had to be hidden to fit within WidthMax as
+	// directed by ColumnConfig.Priority
+	NoteHiddenColumns bool
+	// SplitWide splits the columns of a Table that does not fit within
+	// WidthMax into panes that do, and renders them one below the other
+	// with the index column (see Table.SetIndexColumn) and the auto-index
+	// column repeated in every pane; the panes are separated like pages
+	// so that Table.Pager moves across the panes of a page before moving
+	// on to the next page
+	SplitWide bool
+	// WidthDistribute makes all the columns shrinkable (see
+	// ColumnConfig.WidthShrinkable) to fit within WidthMax, with the
+	// available width distributed across the columns in proportion to
+	// their widths and their ColumnConfig.WidthWeight, instead of
+	// truncating the rows
+	WidthDistribute bool
 	// WidthMax is the maximum allotted width for the full row;
 	// any content beyond this will be truncated using the text
-	// in Style.Box.UnfinishedRow
+	// in Style.Box.UnfinishedRow, after shrinking and hiding
+	// columns as directed by ColumnConfig.WidthShrinkable and
+	// ColumnConfig.Priority
 	WidthMax int
 	// WidthMin is the minimum allotted width for the full row;
 	// columns will be auto-expanded until the overall width
@@ -15,7 +34,10 @@ type SizeOptions struct {
 var (
 	// SizeOptionsDefault defines sensible size options - basically NONE.
 	SizeOptionsDefault = SizeOptions{
-		WidthMax: 0,
-		WidthMin: 0,
+		NoteHiddenColumns: false,
+		SplitWide:         false,
+		WidthDistribute:   false,
+		WidthMax:          0,
+		WidthMin:          0,
 	}
 )
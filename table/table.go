@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"io"
 	"strings"
-	"time"
 	"unicode"
 
 	"github.com/tinybit/go-pretty/v6/text"
@@ -21,6 +20,15 @@ type Table struct {
 	// caption stores the text to be rendered just below the table; and doesn't
 	// get used when rendered as a CSV
 	caption string
+	// cellSpans maps the rows (after filtering and sorting) to the Cells
+	// spanning multiple columns/rows and is generated before rendering
+	cellSpans cellSpans
+	// cellSpansFooter maps the footer rows to the Cells spanning multiple
+	// columns/rows and is generated before rendering
+	cellSpansFooter cellSpans
+	// cellSpansHeader maps the header rows to the Cells spanning multiple
+	// columns/rows and is generated before rendering
+	cellSpansHeader cellSpans
 	// columnIsNonNumeric stores if a column contains non-numbers in all rows
 	columnIsNonNumeric []bool
 	// columnConfigs stores the custom-configuration for 1 or more columns
@@ -45,6 +53,9 @@ type Table struct {
 	maxRowLength int
 	// numColumns stores the (max.) number of columns seen
 	numColumns int
+	// numColumnsHiddenToFit is the number of columns hidden to fit the Table
+	// within Style().Size.WidthMax
+	numColumnsHiddenToFit int
 	// numLinesRendered keeps track of the number of lines rendered and helps in
 	// paginating long tables
 	numLinesRendered int
@@ -52,10 +63,19 @@ type Table struct {
 	outputMirror io.Writer
 	// pager controls how the output is separated into pages
 	pager pager
+	// paneColumns stores the (raw) columns to render in the pane being
+	// rendered when splitting a wide Table into panes
+	paneColumns map[int]bool
 	// renderMode contains the type of table to render
 	renderMode renderMode
 	// rows stores the rows that make up the body (in string form)
 	rows []rowStr
+	// rowsCellColors stores the text.Colors over-rides for each cell as
+	// defined by ColumnConfig.ColorRules and ColumnConfig.Heatmap
+	rowsCellColors []map[int]text.Colors
+	// rowsCellSpans maps the rows in rowsRaw to the cells in them that are a
+	// part of a Cell spanning multiple columns/rows
+	rowsCellSpans map[int]rowCellSpans
 	// rowsColors stores the text.Colors over-rides for each row as defined by
 	// rowPainter or rowPainterWithAttributes
 	rowsColors []text.Colors
@@ -65,14 +85,26 @@ type Table struct {
 	rowsRaw []Row
 	// rowsRawFiltered is the filtered version of rowsRaw
 	rowsRawFiltered []Row
+	// rowsRawFilteredCellSpans has the rowCellSpans for each row in
+	// rowsRawFiltered, and is generated before rendering
+	rowsRawFilteredCellSpans []rowCellSpans
+	// rowsRawTransposed has the raw values for the rows after the rows and
+	// columns have been swapped for rendering
+	rowsRawTransposed []Row
 	// rowsFooter stores the rows that make up the footer (in string form)
 	rowsFooter []rowStr
+	// rowsFooterCellSpans maps the footer rows to the cells in them that are
+	// a part of a Cell spanning multiple columns/rows
+	rowsFooterCellSpans map[int]rowCellSpans
 	// rowsFooterConfigs stores RowConfig for each footer row
 	rowsFooterConfigMap map[int]RowConfig
 	// rowsFooterRaw stores the rows that make up the footer
 	rowsFooterRaw []Row
 	// rowsHeader stores the rows that make up the header (in string form)
 	rowsHeader []rowStr
+	// rowsHeaderCellSpans maps the header rows to the cells in them that are
+	// a part of a Cell spanning multiple columns/rows
+	rowsHeaderCellSpans map[int]rowCellSpans
 	// rowsHeaderConfigs stores RowConfig for each header row
 	rowsHeaderConfigMap map[int]RowConfig
 	// rowsHeaderRaw stores the rows that make up the header
@@ -94,8 +126,23 @@ type Table struct {
 	sortBy []SortBy
 	// sortedRowIndices is the output of sorting
 	sortedRowIndices []int
+	// filter stores the boolean filter expression
+	filter Filter
 	// filterBy stores the filter criteria
 	filterBy []FilterBy
+	// groupBy stores the grouping criteria
+	groupBy []GroupBy
+	// groupRows contains information about each row being rendered (including
+	// the group header/subtotal rows) when the rows are grouped
+	groupRows []groupRow
+	// groupRowsCellSpans has the rowCellSpans for each row in groupRowsRaw
+	groupRowsCellSpans []rowCellSpans
+	// groupRowsRaw stores the raw group header/subtotal rows, which follow the
+	// rows in rowsRawFiltered in the numbering used by sortedRowIndices
+	groupRowsRaw []Row
+	// structColumnConfigs stores the names of the columns for which
+	// AppendStructs has already generated column configurations
+	structColumnConfigs map[string]bool
 	// style contains all the strings used to draw the table, and more
 	style *Style
 	// suppressEmptyColumns hides columns which have no content on all regular
@@ -105,12 +152,32 @@ type Table struct {
 	suppressTrailingSpaces bool
 	// title contains the text to appear above the table
 	title string
+	// transposed tells if the rows and columns have been swapped for rendering
+	transposed bool
+	// transposedColumnConfigs has the ColumnConfig of the column that became
+	// each row after the rows and columns have been swapped
+	transposedColumnConfigs []ColumnConfig
+	// transposedFooterColumns is the number of (right-most) columns containing
+	// the Footer cells after the rows and columns have been swapped
+	transposedFooterColumns int
+	// transposedHeaderColumns is the number of (left-most) columns containing
+	// the Header cells after the rows and columns have been swapped
+	transposedHeaderColumns int
+	// version is bumped on every change made to the Table, so that the Pager
+	// can tell if the pages rendered earlier are out of date
+	version int
+	// visibleColumns maps the index of each column being rendered to the
+	// index of the column in the raw rows; nil if no column is hidden
+	visibleColumns []int
 }
 
 // AppendFooter appends the row to the List of footers to render.
 //
 // Only the first item in the "config" will be tagged against this row.
 func (t *Table) AppendFooter(row Row, config ...RowConfig) {
+	t.version++
+	row, spans := expandCellSpans(row, t.rowsFooterCellSpans[len(t.rowsFooterRaw)-1])
+	t.rowsFooterCellSpans = setRowCellSpans(t.rowsFooterCellSpans, len(t.rowsFooterRaw), spans)
 	t.rowsFooterRaw = append(t.rowsFooterRaw, row)
 	if len(config) > 0 {
 		if t.rowsFooterConfigMap == nil {
@@ -124,6 +191,9 @@ func (t *Table) AppendFooter(row Row, config ...RowConfig) {
 //
 // Only the first item in the "config" will be tagged against this row.
 func (t *Table) AppendHeader(row Row, config ...RowConfig) {
+	t.version++
+	row, spans := expandCellSpans(row, t.rowsHeaderCellSpans[len(t.rowsHeaderRaw)-1])
+	t.rowsHeaderCellSpans = setRowCellSpans(t.rowsHeaderCellSpans, len(t.rowsHeaderRaw), spans)
 	t.rowsHeaderRaw = append(t.rowsHeaderRaw, row)
 	if len(config) > 0 {
 		if t.rowsHeaderConfigMap == nil {
@@ -137,6 +207,9 @@ func (t *Table) AppendHeader(row Row, config ...RowConfig) {
 //
 // Only the first item in the "config" will be tagged against this row.
 func (t *Table) AppendRow(row Row, config ...RowConfig) {
+	t.version++
+	row, spans := expandCellSpans(row, t.rowsCellSpans[len(t.rowsRaw)-1])
+	t.rowsCellSpans = setRowCellSpans(t.rowsCellSpans, len(t.rowsRaw), spans)
 	t.rowsRawFiltered = append(t.rowsRawFiltered, row)
 	// Keep original rows in sync for filtering
 	rowCopy := make(Row, len(row))
@@ -175,6 +248,7 @@ func (t *Table) AppendRows(rows []Row, config ...RowConfig) {
 //
 // ******************************************************************************
 func (t *Table) AppendSeparator() {
+	t.version++
 	if t.separators == nil {
 		t.separators = make(map[int]bool)
 	}
@@ -183,12 +257,36 @@ func (t *Table) AppendSeparator() {
 	}
 }
 
+// Filter sets a boolean expression for filtering the Rows, built using And, Or,
+// Not and FilterBy predicates. If FilterBy rules are also set, a Row has to
+// match both of them to be rendered. Filters are applied before sorting, and
+// FilterBy predicates on unknown columns are ignored like with FilterBy.
+func (t *Table) Filter(filter Filter) {
+	t.version++
+	t.filter = filter
+}
+
 // FilterBy sets the rules for filtering the Rows. All filters are applied with
 // AND logic (all must match). Filters are applied before sorting.
 func (t *Table) FilterBy(filterBy []FilterBy) {
+	t.version++
 	t.filterBy = filterBy
 }
 
+// GroupBy sets the rules for grouping the Rows, with each subsequent GroupBy
+// instruction splitting the groups formed by the previous ones further. Each
+// group is rendered with a header row above it, and a subtotal row below it
+// with the values of the columns in GroupBy.Aggregates summarized. Grouping is
+// done after filtering and sorting, with the groups appearing in the order in
+// which their first rows appear and the Rows in each retaining their order.
+//
+// The value being grouped by is a part of the group header row; hide the
+// column using ColumnConfig.Hidden to avoid repeating it in every Row.
+func (t *Table) GroupBy(groupBy []GroupBy) {
+	t.version++
+	t.groupBy = groupBy
+}
+
 // ImportGrid helps import 1d or 2d arrays as rows.
 func (t *Table) ImportGrid(grid interface{}) bool {
 	rows := objAsSlice(grid)
@@ -214,43 +312,40 @@ func (t *Table) Length() int {
 }
 
 // Pager returns an object that splits the table output into pages and
-// lets you move back and forth through them.
+// lets you move back and forth through them. The table is rendered only when
+// the Pager is first asked for a page, and rendered again only if the table
+// or the options have changed since.
 func (t *Table) Pager(opts ...PagerOption) Pager {
 	for _, opt := range opts {
 		opt(t)
 	}
 
-	// use a temporary page separator for splitting up the pages
-	tempPageSep := fmt.Sprintf("%p // page separator // %d", t.rows, time.Now().UnixNano())
-
-	// backup
-	origOutputMirror, origPageSep := t.outputMirror, t.Style().Box.PageSeparator
-	// restore on exit
-	defer func() {
-		t.outputMirror = origOutputMirror
-		t.Style().Box.PageSeparator = origPageSep
-	}()
-	// override
-	t.outputMirror = nil
-	t.Style().Box.PageSeparator = tempPageSep
-	// render
-	t.pager.pages = strings.Split(t.Render(), tempPageSep)
-
+	// render afresh (lazily) with the new options if any
+	if len(opts) > 0 {
+		t.pager.pages = nil
+	}
+	t.pager.table = t
 	return &t.pager
 }
 
 // ResetFooters resets and clears all the Footer rows appended earlier.
 func (t *Table) ResetFooters() {
+	t.version++
+	t.rowsFooterCellSpans = nil
 	t.rowsFooterRaw = nil
 }
 
 // ResetHeaders resets and clears all the Header rows appended earlier.
 func (t *Table) ResetHeaders() {
+	t.version++
+	t.rowsHeaderCellSpans = nil
 	t.rowsHeaderRaw = nil
 }
 
 // ResetRows resets and clears all the rows appended earlier.
 func (t *Table) ResetRows() {
+	t.version++
+	t.rowsCellSpans = nil
 	t.rowsRawFiltered = nil
 	t.rowsRaw = nil
 	t.separators = nil
@@ -262,6 +357,7 @@ func (t *Table) ResetRows() {
 //
 // Deprecated: in favor if Style().Size.WidthMax
 func (t *Table) SetAllowedRowLength(length int) {
+	t.version++
 	t.allowedRowLength = length
 }
 
@@ -270,17 +366,20 @@ func (t *Table) SetAllowedRowLength(length int) {
 // spreadsheet application. NOTE: Appending a Header will void this
 // functionality.
 func (t *Table) SetAutoIndex(autoIndex bool) {
+	t.version++
 	t.autoIndex = autoIndex
 }
 
 // SetCaption sets the text to be rendered just below the table. This will not
 // show up when the Table is rendered as a CSV.
 func (t *Table) SetCaption(format string, a ...interface{}) {
+	t.version++
 	t.caption = fmt.Sprintf(format, a...)
 }
 
 // SetColumnConfigs sets the configs for each Column.
 func (t *Table) SetColumnConfigs(configs []ColumnConfig) {
+	t.version++
 	t.columnConfigs = configs
 }
 
@@ -295,6 +394,7 @@ func (t *Table) SetHTMLCSSClass(cssClass string) {
 // SetIndexColumn sets the given Column # as the column that has the row
 // "Number". Valid values range from 1 to N. Note that this is not 0-indexed.
 func (t *Table) SetIndexColumn(colNum int) {
+	t.version++
 	t.indexColumn = colNum
 }
 
@@ -310,6 +410,7 @@ func (t *Table) SetOutputMirror(mirror io.Writer) {
 // long list of rows that can span pages. Please note that the pagination logic
 // will not consider Header/Footer lines for paging.
 func (t *Table) SetPageSize(numLines int) {
+	t.version++
 	t.pager.size = numLines
 }
 
@@ -318,6 +419,7 @@ func (t *Table) SetPageSize(numLines int) {
 // of each row is determined. This color takes precedence over other ways to
 // set color (ColumnConfig.Color*, SetColor*()).
 func (t *Table) SetRowPainter(painter interface{}) {
+	t.version++
 	// TODO: fix interface on major version bump to accept only
 	// one type of RowPainter: RowPainterWithAttributes renamed to RowPainter
 
@@ -348,11 +450,13 @@ func (t *Table) SetRowPainter(painter interface{}) {
 
 // SetStyle overrides the DefaultStyle with the provided one.
 func (t *Table) SetStyle(style Style) {
+	t.version++
 	t.style = &style
 }
 
 // SetTitle sets the title text to be rendered above the table.
 func (t *Table) SetTitle(format string, a ...interface{}) {
+	t.version++
 	t.title = fmt.Sprintf(format, a...)
 }
 
@@ -360,11 +464,19 @@ func (t *Table) SetTitle(format string, a ...interface{}) {
 // first SortBy instruction takes precedence over the second and so on. Any
 // duplicate instructions on the same column will be discarded while sorting.
 func (t *Table) SortBy(sortBy []SortBy) {
+	t.version++
 	t.sortBy = sortBy
 }
 
 // Style returns the current style.
 func (t *Table) Style() *Style {
+	// the style could be changed using the pointer
+	t.version++
+	return t.getStyle()
+}
+
+// getStyle returns the current style, or the default style if none was set.
+func (t *Table) getStyle() *Style {
 	if t.style == nil {
 		tempStyle := StyleDefault
 		t.style = &tempStyle
@@ -380,11 +492,13 @@ func (t *Table) Style() *Style {
 // SuppressEmptyColumns hides columns when the column is empty in ALL the
 // regular rows.
 func (t *Table) SuppressEmptyColumns() {
+	t.version++
 	t.suppressEmptyColumns = true
 }
 
 // SuppressTrailingSpaces removes all trailing spaces from the output.
 func (t *Table) SuppressTrailingSpaces() {
+	t.version++
 	t.suppressTrailingSpaces = true
 }
 
@@ -415,7 +529,9 @@ func (t *Table) calculateNumColumnsFromRaw() {
 
 func (t *Table) getAlign(colIdx int, hint renderHint) text.Align {
 	align := text.AlignDefault
-	if cfg, ok := t.columnConfigMap[colIdx]; ok {
+	if t.transposed {
+		align = t.getAlignTransposed(colIdx, hint)
+	} else if cfg, ok := t.columnConfigMap[colIdx]; ok {
 		if hint.isHeaderRow {
 			align = cfg.AlignHeader
 		} else if hint.isFooterRow {
@@ -509,17 +625,47 @@ func (t *Table) getBorderRight(hint renderHint) string {
 	return border
 }
 
+// getCellColSpan returns the number of columns, starting with the given one,
+// that are a part of the same Cell in the row.
+func (t *Table) getCellColSpan(colIdx int, hint renderHint) int {
+	spans, rowIdx := t.getCellSpans(hint), hint.rowNumber-1
+	numColumns := 1
+	for spans.areMerged(rowIdx, colIdx, rowIdx, colIdx+numColumns) {
+		numColumns++
+	}
+	return numColumns
+}
+
+func (t *Table) getCellSpans(hint renderHint) cellSpans {
+	if hint.isAutoIndexRow {
+		return nil
+	} else if hint.isHeaderRow {
+		return t.cellSpansHeader
+	} else if hint.isFooterRow {
+		return t.cellSpansFooter
+	}
+	return t.cellSpans
+}
+
 func (t *Table) getColumnColors(colIdx int, hint renderHint) text.Colors {
 	if hint.isBorderOrSeparator() {
 		if colors := t.getColumnColorsForBorderOrSeparator(hint); colors != nil {
 			return colors
 		}
 	}
+	if hint.isRegularNonSeparatorRow() {
+		if colors := t.getGroupRowColors(hint.rowNumber - 1); colors != nil {
+			return colors
+		}
+	}
 	if t.hasRowPainter() && hint.isRegularNonSeparatorRow() && !t.isIndexColumn(colIdx, hint) {
 		if colors := t.rowsColors[hint.rowNumber-1]; colors != nil {
 			return colors
 		}
 	}
+	if colors := t.getCellColors(colIdx, hint); colors != nil {
+		return colors
+	}
 	if cfg, ok := t.columnConfigMap[colIdx]; ok {
 		if hint.isSeparatorRow {
 			return nil
@@ -662,6 +808,9 @@ func (t *Table) getMaxColumnLengthForMerging(colIdx int) int {
 // getMergedColumnIndices returns a map of colIdx values to all the other colIdx
 // values (that are being merged) and their lengths.
 func (t *Table) getMergedColumnIndices(row rowStr, hint renderHint) mergedColumnIndices {
+	if t.getCellSpans(hint).hasRow(hint.rowNumber - 1) {
+		return t.getMergedColumnIndicesForCellSpans(hint)
+	}
 	if !t.getRowConfig(hint).AutoMerge {
 		return nil
 	}
@@ -686,6 +835,19 @@ func (t *Table) getMergedColumnIndices(row rowStr, hint renderHint) mergedColumn
 	return mci
 }
 
+// getMergedColumnIndicesForCellSpans is similar to getMergedColumnIndices, but
+// for the Cells spanning multiple columns.
+func (t *Table) getMergedColumnIndicesForCellSpans(hint renderHint) mergedColumnIndices {
+	mci := make(mergedColumnIndices)
+	for colIdx := 0; colIdx < t.numColumns; colIdx++ {
+		if numColumns := t.getCellColSpan(colIdx, hint); numColumns > 1 {
+			mci[colIdx] = colIdx + numColumns - 1
+			colIdx += numColumns - 1
+		}
+	}
+	return mci
+}
+
 func (t *Table) getRow(rowIdx int, hint renderHint) rowStr {
 	switch {
 	case hint.isHeaderRow:
@@ -704,6 +866,31 @@ func (t *Table) getRow(rowIdx int, hint renderHint) rowStr {
 	return rowStr{}
 }
 
+// getRawValue returns the raw value (as appended) of the given cell being
+// rendered, with Cells unwrapped to their Value.
+func (t *Table) getRawValue(colIdx int, hint renderHint) interface{} {
+	var row Row
+	rowIdx := hint.rowNumber - 1
+	switch {
+	case hint.isAutoIndexRow:
+		return nil
+	case hint.isHeaderRow:
+		if rowIdx >= 0 && rowIdx < len(t.rowsHeaderRaw) {
+			row = t.rowsHeaderRaw[rowIdx]
+		}
+	case hint.isFooterRow:
+		if rowIdx >= 0 && rowIdx < len(t.rowsFooterRaw) {
+			row = t.rowsFooterRaw[rowIdx]
+		}
+	default:
+		row = t.getRawRow(rowIdx)
+	}
+	if rawColIdx := t.getRawColumnIndex(colIdx); rawColIdx < len(row) {
+		return row[rawColIdx]
+	}
+	return nil
+}
+
 func (t *Table) getRowConfig(hint renderHint) RowConfig {
 	rowIdx := hint.rowNumber - 1
 	if rowIdx < 0 {
@@ -711,11 +898,16 @@ func (t *Table) getRowConfig(hint renderHint) RowConfig {
 	}
 
 	switch {
+	case t.transposed:
+		return RowConfig{}
 	case hint.isHeaderRow:
 		return t.rowsHeaderConfigMap[rowIdx]
 	case hint.isFooterRow:
 		return t.rowsFooterConfigMap[rowIdx]
 	default:
+		if rowConfig, ok := t.getGroupRowConfig(rowIdx); ok {
+			return rowConfig
+		}
 		return t.rowsConfigMap[rowIdx]
 	}
 }
@@ -809,7 +1001,10 @@ func (t *Table) hideColumns() map[int]int {
 }
 
 func (t *Table) isIndexColumn(colIdx int, hint renderHint) bool {
-	return t.indexColumn == colIdx+1 || hint.isAutoIndexColumn
+	if t.transposed {
+		return colIdx < t.transposedHeaderColumns || hint.isAutoIndexColumn
+	}
+	return t.indexColumn == t.getRawColumnIndex(colIdx)+1 || hint.isAutoIndexColumn
 }
 
 func (t *Table) render(out *strings.Builder) string {
@@ -834,6 +1029,7 @@ func (t *Table) shouldMergeCellsHorizontallyAbove(row rowStr, colIdx int, hint r
 	}
 
 	rowConfig := t.getRowConfig(hint)
+	rowHint := hint
 	if hint.isSeparatorRow {
 		if hint.isHeaderRow && hint.rowNumber == 1 {
 			rowConfig = t.getRowConfig(hint)
@@ -841,13 +1037,18 @@ func (t *Table) shouldMergeCellsHorizontallyAbove(row rowStr, colIdx int, hint r
 		} else if hint.isFooterRow && hint.isFirstRow {
 			rowConfig = t.getRowConfig(renderHint{isLastRow: true, rowNumber: len(t.rows)})
 			row = t.getRow(len(t.rows)-1, renderHint{})
+			rowHint = renderHint{rowNumber: len(t.rows)}
 		} else if hint.isFooterRow && hint.isBorderBottom {
 			row = t.getRow(len(t.rowsFooter)-1, renderHint{isFooterRow: true})
+			rowHint = renderHint{isFooterRow: true, rowNumber: len(t.rowsFooter)}
 		} else {
 			row = t.getRow(hint.rowNumber-1, hint)
 		}
 	}
 
+	if t.getCellColSpan(colIdx-1, rowHint) > 1 {
+		return true
+	}
 	if rowConfig.AutoMerge {
 		return row.areEqual(colIdx-1, colIdx)
 	}
@@ -860,25 +1061,34 @@ func (t *Table) shouldMergeCellsHorizontallyBelow(row rowStr, colIdx int, hint r
 	}
 
 	var rowConfig RowConfig
+	var rowHint renderHint
 	if hint.isSeparatorRow {
 		if hint.isRegularRow() {
 			rowConfig = t.getRowConfig(renderHint{rowNumber: hint.rowNumber + 1})
 			row = t.getRow(hint.rowNumber, renderHint{})
+			rowHint = renderHint{rowNumber: hint.rowNumber + 1}
 		} else if hint.isHeaderRow && hint.rowNumber == 0 {
 			rowConfig = t.getRowConfig(renderHint{isHeaderRow: true, rowNumber: 1})
 			row = t.getRow(0, hint)
+			rowHint = renderHint{isHeaderRow: true, rowNumber: 1}
 		} else if hint.isHeaderRow && hint.isLastRow {
 			rowConfig = t.getRowConfig(renderHint{rowNumber: 1})
 			row = t.getRow(0, renderHint{})
+			rowHint = renderHint{rowNumber: 1}
 		} else if hint.isHeaderRow {
 			rowConfig = t.getRowConfig(renderHint{isHeaderRow: true, rowNumber: hint.rowNumber + 1})
 			row = t.getRow(hint.rowNumber, hint)
+			rowHint = renderHint{isHeaderRow: true, rowNumber: hint.rowNumber + 1}
 		} else if hint.isFooterRow && hint.rowNumber >= 0 {
 			rowConfig = t.getRowConfig(renderHint{isFooterRow: true, rowNumber: 1})
 			row = t.getRow(hint.rowNumber, renderHint{isFooterRow: true})
+			rowHint = renderHint{isFooterRow: true, rowNumber: hint.rowNumber + 1}
 		}
 	}
 
+	if hint.isSeparatorRow && t.getCellColSpan(colIdx-1, rowHint) > 1 {
+		return true
+	}
 	if rowConfig.AutoMerge {
 		return row.areEqual(colIdx-1, colIdx)
 	}
@@ -886,6 +1096,14 @@ func (t *Table) shouldMergeCellsHorizontallyBelow(row rowStr, colIdx int, hint r
 }
 
 func (t *Table) shouldMergeCellsVerticallyAbove(colIdx int, hint renderHint) bool {
+	if !t.firstRowOfPage {
+		spans, rowIdx := t.getCellSpans(hint), hint.rowNumber-1
+		if hint.isSeparatorRow && spans.areMerged(rowIdx, colIdx, rowIdx+1, colIdx) {
+			return true
+		} else if !hint.isSeparatorRow && spans.areMerged(rowIdx-1, colIdx, rowIdx, colIdx) {
+			return true
+		}
+	}
 	if !t.firstRowOfPage && t.columnConfigMap[colIdx].AutoMerge && colIdx < t.numColumns {
 		if hint.isSeparatorRow {
 			rowPrev := t.getRow(hint.rowNumber-1, hint)
@@ -905,6 +1123,15 @@ func (t *Table) shouldMergeCellsVerticallyAbove(colIdx int, hint renderHint) boo
 }
 
 func (t *Table) shouldMergeCellsVerticallyBelow(colIdx int, hint renderHint) int {
+	spans, rowIdx := t.getCellSpans(hint), hint.rowNumber-1
+	if cell := spans.get(rowIdx, colIdx); cell != nil {
+		numRowsToMerge := 1
+		for spans.areMerged(rowIdx, colIdx, rowIdx+numRowsToMerge, colIdx) {
+			numRowsToMerge++
+		}
+		return numRowsToMerge
+	}
+
 	numRowsToMerge := 0
 	if t.columnConfigMap[colIdx].AutoMerge && colIdx < t.numColumns {
 		numRowsToMerge = 1
@@ -921,9 +1148,9 @@ func (t *Table) shouldMergeCellsVerticallyBelow(colIdx int, hint renderHint) int
 	return numRowsToMerge
 }
 
-func (t *Table) shouldSeparateRows(rowIdx int, numRows int) bool {
-	// not asked to separate rows and no manually added separator
-	if !t.style.Options.SeparateRows && !t.separators[rowIdx] {
+func (t *Table) shouldSeparateRows(rowIdx int, numRows int, hint renderHint) bool {
+	// not asked to separate rows and no manually added (or group) separator
+	if !t.style.Options.SeparateRows && !t.hasSeparatorAfter(rowIdx, hint) {
 		return false
 	}
 
@@ -940,6 +1167,15 @@ func (t *Table) shouldSeparateRows(rowIdx int, numRows int) bool {
 	return true
 }
 
+// isNestedBlockWithinWidth returns true if the cell contains a Renderable
+// value that has been rendered within the given width.
+func (t *Table) isNestedBlockWithinWidth(colIdx int, colStr string, maxWidth int, hint renderHint) bool {
+	if _, ok := t.getRawValue(colIdx, hint).(Renderable); !ok || t.getColumnTransformer(colIdx, hint) != nil {
+		return false
+	}
+	return text.LongestLineLen(colStr) <= maxWidth
+}
+
 func (t *Table) wrapRow(row rowStr, hint renderHint) (int, rowStr) {
 	colMaxLines := 0
 	rowWrapped := make(rowStr, len(row))
@@ -951,7 +1187,12 @@ func (t *Table) wrapRow(row rowStr, hint renderHint) (int, rowStr) {
 		if maxWidth == 0 {
 			maxWidth = t.maxColumnLengths[colIdx]
 		}
-		rowWrapped[colIdx] = widthEnforcer(colStr, maxWidth)
+		if t.isNestedBlockWithinWidth(colIdx, colStr, maxWidth, hint) {
+			// nested tables/lists are already laid out to fit the column
+			rowWrapped[colIdx] = colStr
+		} else {
+			rowWrapped[colIdx] = widthEnforcer(colStr, maxWidth)
+		}
 
 		// if the column is going to be merged with the one to the left, then
 		// ignore the height of this column as it will be hidden
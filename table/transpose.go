@@ -0,0 +1,134 @@
+package table
+
+import (
+	"fmt"
+
+	"github.com/tinybit/go-pretty/v6/text"
+)
+
+// initForRenderTranspose swaps the rows and columns of the (filtered, sorted,
+// grouped and stringified) Table if Style().Options.Transpose is set, with the
+// Header rows becoming the left-most columns and the Footer rows becoming the
+// right-most columns.
+//
+// The ColumnConfig Transformers are already applied at this point, and the
+// colors (including those from the ColorRules and the Heatmap, and the
+// formatting of the Header/Footer cells) and the WidthMax limits get applied
+// to the cells before they move. The alignment of each
+// column applies to the row it becomes (refer getAlignTransposed); everything
+// else in the column/row configurations refers to the columns/rows before
+// transposing and gets ignored.
+func (t *Table) initForRenderTranspose() {
+	if t.style == nil || !t.style.Options.Transpose || t.renderMode == renderModeJSON || t.renderMode == renderModeNDJSON {
+		return
+	}
+
+	numHeaderRows, numRows, numFooterRows := len(t.rowsHeader), len(t.rows), len(t.rowsFooter)
+	numColumns := numHeaderRows + numRows + numFooterRows
+	rows := make([]rowStr, t.numColumns)
+	rowsRaw := make([]Row, t.numColumns)
+	columnConfigs := make([]ColumnConfig, t.numColumns)
+	columnIsNonNumeric := make([]bool, numColumns)
+	for colIdx := 0; colIdx < t.numColumns; colIdx++ {
+		rows[colIdx] = make(rowStr, 0, numColumns)
+		rowsRaw[colIdx] = make(Row, 0, numColumns)
+		colCfg := t.columnConfigMap[colIdx]
+		columnConfigs[colIdx] = colCfg
+		rawColIdx := t.getRawColumnIndex(colIdx)
+		getString := func(row rowStr) string {
+			return colCfg.getWidthMaxEnforcer()(transposeGetString(row, colIdx), colCfg.WidthMax)
+		}
+
+		for rowIdx, row := range t.rowsHeader {
+			str := t.style.Format.Header.Apply(getString(row))
+			rows[colIdx] = append(rows[colIdx], transposeColorize(str, colCfg.ColorsHeader))
+			rowsRaw[colIdx] = append(rowsRaw[colIdx], transposeGetRaw(t.rowsHeaderRaw[rowIdx], rawColIdx))
+			columnIsNonNumeric[rowIdx] = true
+		}
+		for rowIdx, row := range t.rows {
+			colors := colCfg.Colors
+			if groupColors := t.getGroupRowColors(rowIdx); groupColors != nil {
+				colors = groupColors
+			} else if t.hasRowPainter() && t.rowsColors[rowIdx] != nil {
+				colors = t.rowsColors[rowIdx]
+			} else if t.rowsCellColors != nil && t.rowsCellColors[rowIdx][rawColIdx] != nil {
+				colors = t.rowsCellColors[rowIdx][rawColIdx]
+			}
+			raw := transposeGetRaw(t.getRawRow(rowIdx), rawColIdx)
+			rows[colIdx] = append(rows[colIdx], transposeColorize(getString(row), colors))
+			rowsRaw[colIdx] = append(rowsRaw[colIdx], raw)
+			if !isNumber(raw) {
+				columnIsNonNumeric[numHeaderRows+rowIdx] = true
+			}
+		}
+		for rowIdx, row := range t.rowsFooter {
+			str := t.style.Format.Footer.Apply(getString(row))
+			rows[colIdx] = append(rows[colIdx], transposeColorize(str, colCfg.ColorsFooter))
+			if rowIdx < len(t.rowsFooterRaw) {
+				rowsRaw[colIdx] = append(rowsRaw[colIdx], transposeGetRaw(t.rowsFooterRaw[rowIdx], rawColIdx))
+			} else { // footer row added for the aggregates
+				rowsRaw[colIdx] = append(rowsRaw[colIdx], nil)
+			}
+			columnIsNonNumeric[numHeaderRows+numRows+rowIdx] = true
+		}
+	}
+
+	t.autoIndexVIndexMaxLength = len(fmt.Sprint(len(rows)))
+	t.columnConfigMap = map[int]ColumnConfig{}
+	t.columnIsNonNumeric = columnIsNonNumeric
+	t.groupRows = nil
+	t.numColumns = numColumns
+	t.rows = rows
+	t.rowsCellColors = nil
+	t.rowsColors = make([]text.Colors, len(rows))
+	t.rowsFooter = nil
+	t.rowsHeader = nil
+	t.rowsRawTransposed = rowsRaw
+	t.sortedRowIndices = nil
+	t.transposed = true
+	t.transposedColumnConfigs = columnConfigs
+	t.transposedFooterColumns = numFooterRows
+	t.transposedHeaderColumns = numHeaderRows
+	t.visibleColumns = nil
+}
+
+// getAlignTransposed returns the alignment for a cell of the transposed Table
+// as set on the column that became its row: AlignHeader for the Header cells,
+// AlignFooter for the Footer cells and Align for the rest.
+func (t *Table) getAlignTransposed(colIdx int, hint renderHint) text.Align {
+	rowIdx := hint.rowNumber - 1
+	if hint.isAutoIndexColumn || hint.isAutoIndexRow || rowIdx < 0 || rowIdx >= len(t.transposedColumnConfigs) {
+		return text.AlignDefault
+	}
+
+	cfg := t.transposedColumnConfigs[rowIdx]
+	switch {
+	case colIdx < t.transposedHeaderColumns:
+		return cfg.AlignHeader
+	case colIdx >= t.numColumns-t.transposedFooterColumns:
+		return cfg.AlignFooter
+	default:
+		return cfg.Align
+	}
+}
+
+func transposeColorize(str string, colors text.Colors) string {
+	if len(colors) > 0 && str != "" {
+		return colors.Sprint(str)
+	}
+	return str
+}
+
+func transposeGetRaw(row Row, colIdx int) interface{} {
+	if colIdx < len(row) {
+		return row[colIdx]
+	}
+	return nil
+}
+
+func transposeGetString(row rowStr, colIdx int) string {
+	if colIdx < len(row) {
+		return row[colIdx]
+	}
+	return ""
+}
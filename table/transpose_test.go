@@ -0,0 +1,111 @@
+package table
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/tinybit/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTable_Render_Transpose(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendHeader(testHeader)
+		tw.AppendRows(testRows)
+		tw.AppendFooter(testFooter)
+		tw.SetStyle(StyleLight)
+		tw.Style().Options.Transpose = true
+
+		compareOutput(t, tw.Render(), `
+┌────────────┬───────┬─────────────────────────────┬───────────┬───────┐
+│ #          │ 1     │ 20                          │ 300       │       │
+│ FIRST NAME │ Arya  │ Jon                         │ Tyrion    │       │
+│ LAST NAME  │ Stark │ Snow                        │ Lannister │ TOTAL │
+│ SALARY     │ 3000  │ 2000                        │ 5000      │ 10000 │
+│            │       │ You know nothing, Jon Snow! │           │       │
+└────────────┴───────┴─────────────────────────────┴───────────┴───────┘`)
+	})
+
+	t.Run("filter sort transform hide", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendHeader(testHeader)
+		tw.AppendRows(testRows)
+		tw.FilterBy([]FilterBy{{Name: "Salary", Operator: GreaterThan, Value: 2000}})
+		tw.SortBy([]SortBy{{Name: "First Name", Mode: Dsc}})
+		tw.SetColumnConfigs([]ColumnConfig{
+			{Name: "#", Hidden: true},
+			{Name: "Salary", Transformer: func(val interface{}) string { return fmt.Sprintf("$%v", val) }},
+		})
+		tw.SetAutoIndex(true)
+		tw.Style().Options.Transpose = true
+
+		compareOutput(t, tw.Render(), `
++---+------------+-----------+-------+
+|   |      A     |     B     |   C   |
++---+------------+-----------+-------+
+| 1 | FIRST NAME | Tyrion    | Arya  |
+| 2 | LAST NAME  | Lannister | Stark |
+| 3 | SALARY     | $5000     | $3000 |
++---+------------+-----------+-------+`)
+
+		// and back to normal
+		tw.Style().Options.Transpose = false
+		compareOutput(t, tw.Render(), `
++---+------------+-----------+--------+
+|   | FIRST NAME | LAST NAME | SALARY |
++---+------------+-----------+--------+
+| 1 | Tyrion     | Lannister |  $5000 |
+| 2 | Arya       | Stark     |  $3000 |
++---+------------+-----------+--------+`)
+	})
+
+	t.Run("align and width", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendHeader(Row{"Name", "Score", "Motto"})
+		tw.AppendRows([]Row{{"Arya", 3, "Not today"}, {"Jon", 12, "Winter is coming"}})
+		tw.AppendFooter(Row{"Total", 15, ""})
+		tw.SetColumnConfigs([]ColumnConfig{
+			{Name: "Name", Align: text.AlignCenter, AlignHeader: text.AlignRight},
+			{Name: "Score", Align: text.AlignRight, AlignFooter: text.AlignCenter},
+			{Name: "Motto", WidthMax: 6, WidthMaxEnforcer: text.WrapSoft},
+		})
+		tw.Style().Options.Transpose = true
+
+		compareOutput(t, tw.Render(), `
++-------+--------+--------+-------+
+|  NAME |  Arya  |   Jon  | TOTAL |
+| SCORE |      3 |     12 |   15  |
+| MOTTO | Not    | Winter |       |
+|       | today  | is     |       |
+|       |        | coming |       |
++-------+--------+--------+-------+`)
+		assert.Equal(t, 2, tw.Length())
+	})
+
+	t.Run("colors", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendHeader(Row{"Name", "Score"})
+		tw.AppendRows([]Row{{"Arya", 3}, {"Jon", 2}})
+		tw.SetColumnConfigs([]ColumnConfig{{Name: "Score", Colors: text.Colors{text.FgGreen}, ColorsHeader: text.Colors{text.Bold}}})
+		tw.SetStyle(StyleColoredDark)
+		tw.Style().Options.Transpose = true
+
+		compareOutputColored(t, tw.Render(), ""+
+			"\x1b[96;100m NAME  \x1b[0m\x1b[97;40m Arya \x1b[0m\x1b[97;40m Jon \x1b[0m\n"+
+			"\x1b[96;100m \x1b[1mSCORE\x1b[0m\x1b[96;100m \x1b[0m\x1b[37;40m \x1b[32m3\x1b[0m\x1b[37;40m    \x1b[0m\x1b[37;40m \x1b[32m2\x1b[0m\x1b[37;40m   \x1b[0m")
+	})
+
+	t.Run("other formats", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendHeader(Row{"Name", "Score"})
+		tw.AppendRows([]Row{{"Arya", 3}, {"Jon", 2}})
+		tw.Style().Options.Transpose = true
+
+		assert.Equal(t, "NAME,Arya,Jon\nSCORE,3,2", tw.RenderCSV())
+		assert.Equal(t, `[
+  {"Name":"Arya","Score":3},
+  {"Name":"Jon","Score":2}
+]`, tw.RenderJSON())
+	})
+}
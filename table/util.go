@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"sort"
 	"strconv"
+	"strings"
 )
 
 // AutoIndexColumnID returns a unique Column ID/Name for the given Column Number.
@@ -65,6 +66,8 @@ func convertValueToString(v interface{}) string {
 		return "false"
 	case string:
 		return val
+	case Renderable:
+		return val.Render()
 	default:
 		return fmt.Sprint(v)
 	}
@@ -145,3 +148,14 @@ func getSortedKeys(input map[int]map[int]int) ([]int, map[int][]int) {
 	sort.Ints(keys)
 	return keys, subkeysMap
 }
+
+// splitPages splits the rendered output into pages at the given separator. The
+// line break that follows each separator starts the next page in the output,
+// and gets dropped so that the pages do not begin with an empty line.
+func splitPages(out string, separator string) []string {
+	pages := strings.Split(out, separator)
+	for idx := 1; idx < len(pages); idx++ {
+		pages[idx] = strings.TrimPrefix(pages[idx], "\n")
+	}
+	return pages
+}
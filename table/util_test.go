@@ -159,6 +159,12 @@ func Test_objAsSlice(t *testing.T) {
 	assert.Equal(t, "[<nil> 2]", fmt.Sprint(objAsSlice(&[]*int{nil, &b, nil})))
 }
 
+func Test_splitPages(t *testing.T) {
+	assert.Equal(t, []string{"a\nb"}, splitPages("a\nb", "--"))
+	assert.Equal(t, []string{"a", "b\nc", "\nd"}, splitPages("a--\nb\nc--\n\nd", "--"))
+	assert.Equal(t, []string{"\na", "b"}, splitPages("\na--b", "--"))
+}
+
 func Test_objIsSlice(t *testing.T) {
 	assert.True(t, objIsSlice([]int{}))
 	assert.True(t, objIsSlice([]*int{}))
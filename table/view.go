@@ -0,0 +1,543 @@
+package table
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/tinybit/go-pretty/v6/text"
+	"golang.org/x/term"
+)
+
+// ErrViewUnsupportedWriter is returned by View when given a Writer that was
+// not created using NewWriter.
+var ErrViewUnsupportedWriter = errors.New("table: view needs a Writer created using NewWriter")
+
+// ViewHeightDefault is the number of lines used by View when the height of the
+// screen cannot be determined otherwise.
+var ViewHeightDefault = 24
+
+const (
+	viewClearScreen    = "\x1b[H\x1b[2J"
+	viewHighlightStart = "\x1b[7m"
+	viewHighlightStop  = "\x1b[27m"
+)
+
+// View lets you browse the Table interactively with keyboard navigation. The
+// title, the header and the footer stay in place while the rows scroll, and
+// the colors are retained. The keys are read from in, and the screen is
+// redrawn on out after every key press:
+//   - Up/Down (or k/j): scroll by a line
+//   - PgUp/PgDn (or b/Space): scroll by a page
+//   - Home/End (or g/G): go to the first/last line
+//   - /: search for text (Enter to confirm, Esc to cancel) and highlight it
+//   - n/N: go to the next/previous line with a match
+//   - Left/Right (or </>): select the previous/next column
+//   - s: sort by the selected column (ascending, descending, and then not)
+//   - h: hide (or show) the selected column
+//   - q (or Ctrl+C): quit
+//
+// The height of the screen is taken from PageHeight or PageHeightFromTerminal
+// if given, and defaults to ViewHeightDefault lines otherwise. The terminal is
+// put into raw mode for the duration if in is one. The sorting and the column
+// configs of the Table are restored on exit.
+func View(w Writer, in io.Reader, out io.Writer, opts ...PagerOption) error {
+	t, ok := w.(*Table)
+	if !ok {
+		return ErrViewUnsupportedWriter
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	if f, ok := in.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		if state, err := term.MakeRaw(int(f.Fd())); err == nil {
+			defer func() {
+				_ = term.Restore(int(f.Fd()), state)
+			}()
+		}
+	}
+
+	v := viewer{
+		colNum:        1,
+		columnConfigs: t.columnConfigs,
+		height:        t.pager.getHeight(),
+		hidden:        make(map[int]bool),
+		in:            bufio.NewReader(in),
+		out:           out,
+		sortBy:        t.sortBy,
+		table:         t,
+	}
+	if v.height <= 0 {
+		v.height = ViewHeightDefault
+	}
+	defer func() {
+		t.columnConfigs = v.columnConfigs
+		t.sortBy = v.sortBy
+	}()
+	return v.run()
+}
+
+type viewKey int
+
+const (
+	viewKeyNone viewKey = iota
+	viewKeyDown
+	viewKeyEnd
+	viewKeyEnter
+	viewKeyEscape
+	viewKeyHome
+	viewKeyLeft
+	viewKeyPageDown
+	viewKeyPageUp
+	viewKeyRight
+	viewKeyUp
+)
+
+// viewEscapeSequences maps the escape sequences sent by terminals for the
+// special keys (minus the leading ESC) to the keys.
+var viewEscapeSequences = map[string]viewKey{
+	"[A":  viewKeyUp,
+	"[B":  viewKeyDown,
+	"[C":  viewKeyRight,
+	"[D":  viewKeyLeft,
+	"[H":  viewKeyHome,
+	"[F":  viewKeyEnd,
+	"OH":  viewKeyHome,
+	"OF":  viewKeyEnd,
+	"[1~": viewKeyHome,
+	"[4~": viewKeyEnd,
+	"[5~": viewKeyPageUp,
+	"[6~": viewKeyPageDown,
+}
+
+// viewer holds the state of an interactive View of a Table.
+type viewer struct {
+	// colNum is the (1-indexed) number of the selected column
+	colNum int
+	// columnConfigs stores the column configs set by the user
+	columnConfigs []ColumnConfig
+	// head, rows and foot are the rendered lines
+	head, rows, foot []string
+	// height is the number of lines on the screen
+	height int
+	// hidden overrides the visibility of the columns by their numbers
+	hidden map[int]bool
+	// in is the source of key presses
+	in *bufio.Reader
+	// match is the index of the row line with the current match
+	match int
+	// message is shown in the status line until the next key press
+	message string
+	// out is where the screen is drawn
+	out io.Writer
+	// search is the text being searched for
+	search string
+	// sortBy stores the sorting rules set by the user
+	sortBy []SortBy
+	// sortMode is the sorting on the selected column (if sorted)
+	sortMode *SortMode
+	// sortNum is the number of the column being sorted on by the viewer
+	sortNum int
+	// table is the Table being viewed
+	table *Table
+	// top is the index of the first row line on the screen
+	top int
+}
+
+func (v *viewer) run() error {
+	v.render()
+	for {
+		if err := v.draw(); err != nil {
+			return err
+		}
+		key, r, err := v.readKey()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		v.message = ""
+		switch {
+		case key == viewKeyUp || r == 'k':
+			v.scroll(-1)
+		case key == viewKeyDown || r == 'j':
+			v.scroll(1)
+		case key == viewKeyPageUp || r == 'b':
+			v.scroll(-v.getNumRowsVisible())
+		case key == viewKeyPageDown || r == ' ':
+			v.scroll(v.getNumRowsVisible())
+		case key == viewKeyHome || r == 'g':
+			v.top = 0
+		case key == viewKeyEnd || r == 'G':
+			v.scroll(len(v.rows))
+		case key == viewKeyLeft || r == '<':
+			v.selectColumn(-1)
+		case key == viewKeyRight || r == '>':
+			v.selectColumn(1)
+		case r == '/':
+			if err := v.readSearch(); err != nil {
+				return err
+			}
+		case r == 'n':
+			v.findMatch(v.match+1, 1)
+		case r == 'N':
+			v.findMatch(v.match-1, -1)
+		case r == 's':
+			v.toggleSort()
+		case r == 'h':
+			v.toggleHidden()
+		case r == 'q' || r == 0x03:
+			return nil
+		}
+	}
+}
+
+// draw redraws the screen with the head, the visible rows, the foot and the
+// status line.
+func (v *viewer) draw() error {
+	var out strings.Builder
+	out.WriteString(viewClearScreen)
+	lines := append([]string{}, v.head...)
+	bottom := v.top + v.getNumRowsVisible()
+	if bottom > len(v.rows) {
+		bottom = len(v.rows)
+	}
+	for _, line := range v.rows[v.top:bottom] {
+		lines = append(lines, v.highlight(line))
+	}
+	lines = append(lines, v.foot...)
+	lines = append(lines, v.getStatus(bottom))
+	out.WriteString(strings.Join(lines, "\r\n"))
+
+	_, err := io.WriteString(v.out, out.String())
+	return err
+}
+
+// findMatch moves to the first line with a match starting at the given line,
+// and going in the given direction.
+func (v *viewer) findMatch(from int, direction int) {
+	if v.search == "" {
+		return
+	}
+	for idx := from; idx >= 0 && idx < len(v.rows); idx += direction {
+		if visibleText, _ := viewGetVisibleText(v.rows[idx]); strings.Contains(visibleText, v.search) {
+			v.match, v.top = idx, idx
+			v.scroll(0)
+			return
+		}
+	}
+	v.message = fmt.Sprintf("%q not found", v.search)
+}
+
+func (v *viewer) getColumnName(colNum int) string {
+	if rows := v.table.rowsHeaderRaw; len(rows) > 0 && colNum <= len(rows[0]) {
+		if name := fmt.Sprint(rows[0][colNum-1]); name != "" {
+			return name
+		}
+	}
+	return AutoIndexColumnID(colNum - 1)
+}
+
+func (v *viewer) getNumColumns() int {
+	numColumns := 0
+	for _, rows := range [][]Row{v.table.rowsHeaderRaw, v.table.rowsRaw, v.table.rowsFooterRaw} {
+		for _, row := range rows {
+			if len(row) > numColumns {
+				numColumns = len(row)
+			}
+		}
+	}
+	return numColumns
+}
+
+func (v *viewer) getNumRowsVisible() int {
+	numRowsVisible := v.height - len(v.head) - len(v.foot) - 1
+	if numRowsVisible < 1 {
+		numRowsVisible = 1
+	}
+	return numRowsVisible
+}
+
+// getStatus returns the status line describing the position, the selected
+// column and the search.
+func (v *viewer) getStatus(bottom int) string {
+	status := fmt.Sprintf("lines %d-%d of %d", v.top+1, bottom, len(v.rows))
+	if len(v.rows) == 0 {
+		status = "no lines"
+	}
+	if numColumns := v.getNumColumns(); numColumns > 0 {
+		status += fmt.Sprintf(" | column %d/%d: %s", v.colNum, numColumns, v.getColumnName(v.colNum))
+		if v.isHidden(v.colNum) {
+			status += " (hidden)"
+		} else if v.sortMode != nil && v.sortNum == v.colNum {
+			if *v.sortMode == AscNatural {
+				status += " (sorted asc)"
+			} else {
+				status += " (sorted desc)"
+			}
+		}
+	}
+	if v.search != "" {
+		numMatches := 0
+		for _, line := range v.rows {
+			visibleText, _ := viewGetVisibleText(line)
+			numMatches += strings.Count(visibleText, v.search)
+		}
+		status += fmt.Sprintf(" | /%s: %d matches", v.search, numMatches)
+	}
+	if v.message != "" {
+		status += " | " + v.message
+	}
+	return status
+}
+
+// highlight highlights all the matches of the search text in the line. Only
+// the text outside the escape sequences is searched, and a match interrupted
+// by escape sequences (say, a change of colors) is highlighted in parts around
+// them.
+func (v *viewer) highlight(line string) string {
+	if v.search == "" {
+		return line
+	}
+	visibleText, offsets := viewGetVisibleText(line)
+
+	var out strings.Builder
+	pos := 0
+	for start := 0; start < len(visibleText); {
+		idx := strings.Index(visibleText[start:], v.search)
+		if idx < 0 {
+			break
+		}
+		matchStart, matchEnd := start+idx, start+idx+len(v.search)
+		for runStart := matchStart; runStart < matchEnd; {
+			runEnd := runStart + 1
+			for runEnd < matchEnd && offsets[runEnd] == offsets[runEnd-1]+1 {
+				runEnd++
+			}
+			out.WriteString(line[pos:offsets[runStart]])
+			out.WriteString(viewHighlightStart)
+			out.WriteString(line[offsets[runStart] : offsets[runEnd-1]+1])
+			out.WriteString(viewHighlightStop)
+			pos = offsets[runEnd-1] + 1
+			runStart = runEnd
+		}
+		start = matchEnd
+	}
+	out.WriteString(line[pos:])
+	return out.String()
+}
+
+// isHidden returns true if the column is hidden, either by the viewer or by
+// the column configs set by the user.
+func (v *viewer) isHidden(colNum int) bool {
+	if hidden, ok := v.hidden[colNum]; ok {
+		return hidden
+	}
+	for _, cc := range v.columnConfigs {
+		if v.getColumnConfigNumber(cc) == colNum {
+			return cc.Hidden
+		}
+	}
+	return false
+}
+
+func (v *viewer) getColumnConfigNumber(cc ColumnConfig) int {
+	if cc.Number == 0 && len(v.table.rowsHeaderRaw) > 0 {
+		return v.table.rowsHeaderRaw[0].findColumnNumber(cc.Name)
+	}
+	return cc.Number
+}
+
+// readKey reads the next key press, and returns either the special key or the
+// character typed.
+func (v *viewer) readKey() (viewKey, rune, error) {
+	r, _, err := v.in.ReadRune()
+	if err != nil {
+		return viewKeyNone, 0, err
+	}
+	switch r {
+	case '\r', '\n':
+		return viewKeyEnter, r, nil
+	case 0x1b:
+		// an escape sequence arrives all at once, unlike a lone Esc key
+		var seq strings.Builder
+		for v.in.Buffered() > 0 && seq.Len() < 3 {
+			r, _, err := v.in.ReadRune()
+			if err != nil {
+				break
+			}
+			seq.WriteRune(r)
+			if key, ok := viewEscapeSequences[seq.String()]; ok {
+				return key, 0, nil
+			}
+		}
+		return viewKeyEscape, 0, nil
+	}
+	return viewKeyNone, r, nil
+}
+
+// readSearch reads the text to search for, showing it on the status line as
+// it is typed, and moves to the first match.
+func (v *viewer) readSearch() error {
+	var search []rune
+	for {
+		v.message = "/" + string(search)
+		if err := v.draw(); err != nil {
+			return err
+		}
+		key, r, err := v.readKey()
+		if err != nil && err != io.EOF {
+			return err
+		}
+		switch {
+		case err == io.EOF || key == viewKeyEnter:
+			v.message = ""
+			v.search = string(search)
+			v.findMatch(v.top, 1)
+			return nil
+		case key == viewKeyEscape:
+			v.message = ""
+			return nil
+		case r == 0x7f || r == 0x08:
+			if len(search) > 0 {
+				search = search[:len(search)-1]
+			}
+		case key == viewKeyNone && r >= ' ':
+			search = append(search, r)
+		}
+	}
+}
+
+// render renders the Table afresh into the lines to show on the screen.
+func (v *viewer) render() {
+	v.head, v.rows, v.foot = v.table.renderForView()
+	v.scroll(0)
+}
+
+// scroll moves the rows on the screen by the given number of lines, without
+// going past the first or the last page.
+func (v *viewer) scroll(numLines int) {
+	v.top += numLines
+	if maxTop := len(v.rows) - v.getNumRowsVisible(); v.top > maxTop {
+		v.top = maxTop
+	}
+	if v.top < 0 {
+		v.top = 0
+	}
+}
+
+func (v *viewer) selectColumn(direction int) {
+	v.colNum += direction
+	if numColumns := v.getNumColumns(); v.colNum > numColumns {
+		v.colNum = numColumns
+	}
+	if v.colNum < 1 {
+		v.colNum = 1
+	}
+}
+
+// toggleHidden hides the selected column if visible, and shows it otherwise.
+func (v *viewer) toggleHidden() {
+	v.hidden[v.colNum] = !v.isHidden(v.colNum)
+
+	var columnConfigs []ColumnConfig
+	configured := make(map[int]bool)
+	for _, cc := range v.columnConfigs {
+		colNum := v.getColumnConfigNumber(cc)
+		if hidden, ok := v.hidden[colNum]; ok {
+			cc.Hidden = hidden
+			configured[colNum] = true
+		}
+		columnConfigs = append(columnConfigs, cc)
+	}
+	for colNum, hidden := range v.hidden {
+		if !configured[colNum] {
+			columnConfigs = append(columnConfigs, ColumnConfig{Number: colNum, Hidden: hidden})
+		}
+	}
+	v.table.columnConfigs = columnConfigs
+	v.render()
+}
+
+// toggleSort cycles the sorting on the selected column from ascending to
+// descending to the sorting set by the user.
+func (v *viewer) toggleSort() {
+	switch {
+	case v.sortMode == nil || v.sortNum != v.colNum:
+		mode := AscNatural
+		v.sortMode, v.sortNum = &mode, v.colNum
+	case *v.sortMode == AscNatural:
+		mode := DscNatural
+		v.sortMode = &mode
+	default:
+		v.sortMode, v.sortNum = nil, 0
+	}
+
+	if v.sortMode != nil {
+		v.table.sortBy = []SortBy{{Number: v.sortNum, Mode: *v.sortMode}}
+	} else {
+		v.table.sortBy = v.sortBy
+	}
+	v.render()
+}
+
+// renderForView renders the Table as three sets of lines: the title and the
+// header to be shown at the top, the rows to scroll through, and the footer
+// and the caption to be shown at the bottom. The Pager is not used as the rows
+// scroll by lines under the header, and not in pages of fixed boundaries with
+// the header and the footer repeated on each.
+func (t *Table) renderForView() ([]string, []string, []string) {
+	// the rows are scrolled through instead of being paged
+	origPageSize := t.pager.size
+	defer func() {
+		t.pager.size = origPageSize
+	}()
+	t.pager.size = 0
+
+	t.initForRender(renderModeDefault)
+	var head, rows, foot strings.Builder
+	if t.numColumns > 0 {
+		t.renderTitle(&head)
+		t.renderRowsBorderTop(&head)
+		t.renderRowsHeader(&head)
+		t.renderRows(&rows, t.rows, renderHint{})
+		t.renderRowsFooter(&foot)
+		t.renderRowsBorderBottom(&foot)
+		if caption := t.getCaption(); caption != "" {
+			foot.WriteRune('\n')
+			foot.WriteString(caption)
+		}
+	}
+
+	splitLines := func(out *strings.Builder) []string {
+		if out.Len() == 0 {
+			return nil
+		}
+		return strings.Split(out.String(), "\n")
+	}
+	return splitLines(&head), splitLines(&rows), splitLines(&foot)
+}
+
+// viewGetVisibleText returns the text in the line outside the escape sequences
+// along with the offset in the line of every byte of that text.
+func viewGetVisibleText(line string) (string, []int) {
+	var out strings.Builder
+	offsets := make([]int, 0, len(line))
+	isEscSeq := false
+	for idx := 0; idx < len(line); idx++ {
+		switch {
+		case rune(line[idx]) == text.EscapeStartRune:
+			isEscSeq = true
+		case isEscSeq:
+			isEscSeq = rune(line[idx]) != text.EscapeStopRune
+		default:
+			out.WriteByte(line[idx])
+			offsets = append(offsets, idx)
+		}
+	}
+	return out.String(), offsets
+}
@@ -0,0 +1,296 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tinybit/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestView(t *testing.T) {
+	newTable := func() Writer {
+		tw := NewWriter()
+		tw.AppendHeader(Row{"#", "Name", "House"})
+		tw.AppendRows([]Row{
+			{1, "Arya", "Stark"},
+			{2, "Jon", "Snow"},
+			{3, "Tyrion", "Lannister"},
+			{4, "Sansa", "Stark"},
+			{5, "Bran", "Stark"},
+			{6, "Cersei", "Lannister"},
+			{7, "Daenerys", "Targaryen"},
+			{8, "Jaime", "Lannister"},
+		})
+		tw.AppendFooter(Row{"", "Total", 8})
+		return tw
+	}
+	view := func(t *testing.T, tw Writer, keys string) string {
+		var out strings.Builder
+		assert.Nil(t, View(tw, strings.NewReader(keys), &out, PageHeight(12)))
+
+		// show the highlighted matches as {match} to keep it readable
+		screens := strings.Split(out.String(), viewClearScreen)
+		return strings.NewReplacer(
+			"\r\n", "\n",
+			viewHighlightStart, "{",
+			viewHighlightStop, "}",
+		).Replace(screens[len(screens)-1])
+	}
+
+	t.Run("scroll", func(t *testing.T) {
+		tw := newTable()
+
+		compareOutput(t, view(t, tw, ""), `
++---+----------+-----------+
+| # | NAME     | HOUSE     |
++---+----------+-----------+
+| 1 | Arya     | Stark     |
+| 2 | Jon      | Snow      |
+| 3 | Tyrion   | Lannister |
+| 4 | Sansa    | Stark     |
+| 5 | Bran     | Stark     |
++---+----------+-----------+
+|   | TOTAL    | 8         |
++---+----------+-----------+
+lines 1-5 of 8 | column 1/3: #`)
+		compareOutput(t, view(t, tw, "jj\x1b[B"), `
++---+----------+-----------+
+| # | NAME     | HOUSE     |
++---+----------+-----------+
+| 4 | Sansa    | Stark     |
+| 5 | Bran     | Stark     |
+| 6 | Cersei   | Lannister |
+| 7 | Daenerys | Targaryen |
+| 8 | Jaime    | Lannister |
++---+----------+-----------+
+|   | TOTAL    | 8         |
++---+----------+-----------+
+lines 4-8 of 8 | column 1/3: #`)
+		compareOutput(t, view(t, tw, "\x1b[6~\x1b[6~"), `
++---+----------+-----------+
+| # | NAME     | HOUSE     |
++---+----------+-----------+
+| 4 | Sansa    | Stark     |
+| 5 | Bran     | Stark     |
+| 6 | Cersei   | Lannister |
+| 7 | Daenerys | Targaryen |
+| 8 | Jaime    | Lannister |
++---+----------+-----------+
+|   | TOTAL    | 8         |
++---+----------+-----------+
+lines 4-8 of 8 | column 1/3: #`)
+		compareOutput(t, view(t, tw, "G\x1b[5~k"), `
++---+----------+-----------+
+| # | NAME     | HOUSE     |
++---+----------+-----------+
+| 1 | Arya     | Stark     |
+| 2 | Jon      | Snow      |
+| 3 | Tyrion   | Lannister |
+| 4 | Sansa    | Stark     |
+| 5 | Bran     | Stark     |
++---+----------+-----------+
+|   | TOTAL    | 8         |
++---+----------+-----------+
+lines 1-5 of 8 | column 1/3: #`)
+		compareOutput(t, view(t, tw, "Gg"), `
++---+----------+-----------+
+| # | NAME     | HOUSE     |
++---+----------+-----------+
+| 1 | Arya     | Stark     |
+| 2 | Jon      | Snow      |
+| 3 | Tyrion   | Lannister |
+| 4 | Sansa    | Stark     |
+| 5 | Bran     | Stark     |
++---+----------+-----------+
+|   | TOTAL    | 8         |
++---+----------+-----------+
+lines 1-5 of 8 | column 1/3: #`)
+	})
+
+	t.Run("search", func(t *testing.T) {
+		tw := newTable()
+
+		compareOutput(t, view(t, tw, "/Lannister\r"), `
++---+----------+-----------+
+| # | NAME     | HOUSE     |
++---+----------+-----------+
+| 3 | Tyrion   | {Lannister} |
+| 4 | Sansa    | Stark     |
+| 5 | Bran     | Stark     |
+| 6 | Cersei   | {Lannister} |
+| 7 | Daenerys | Targaryen |
++---+----------+-----------+
+|   | TOTAL    | 8         |
++---+----------+-----------+
+lines 3-7 of 8 | column 1/3: # | /Lannister: 3 matches`)
+		compareOutput(t, view(t, tw, "/Lannister\rnn"), `
++---+----------+-----------+
+| # | NAME     | HOUSE     |
++---+----------+-----------+
+| 4 | Sansa    | Stark     |
+| 5 | Bran     | Stark     |
+| 6 | Cersei   | {Lannister} |
+| 7 | Daenerys | Targaryen |
+| 8 | Jaime    | {Lannister} |
++---+----------+-----------+
+|   | TOTAL    | 8         |
++---+----------+-----------+
+lines 4-8 of 8 | column 1/3: # | /Lannister: 3 matches`)
+		compareOutput(t, view(t, tw, "/Starkx\x7f\rn"), `
++---+----------+-----------+
+| # | NAME     | HOUSE     |
++---+----------+-----------+
+| 4 | Sansa    | {Stark}     |
+| 5 | Bran     | {Stark}     |
+| 6 | Cersei   | Lannister |
+| 7 | Daenerys | Targaryen |
+| 8 | Jaime    | Lannister |
++---+----------+-----------+
+|   | TOTAL    | 8         |
++---+----------+-----------+
+lines 4-8 of 8 | column 1/3: # | /Stark: 3 matches`)
+		compareOutput(t, view(t, tw, "/Baratheon\r"), `
++---+----------+-----------+
+| # | NAME     | HOUSE     |
++---+----------+-----------+
+| 1 | Arya     | Stark     |
+| 2 | Jon      | Snow      |
+| 3 | Tyrion   | Lannister |
+| 4 | Sansa    | Stark     |
+| 5 | Bran     | Stark     |
++---+----------+-----------+
+|   | TOTAL    | 8         |
++---+----------+-----------+
+lines 1-5 of 8 | column 1/3: # | /Baratheon: 0 matches | "Baratheon" not found`)
+		compareOutput(t, view(t, tw, "/Stark\x1b"), `
++---+----------+-----------+
+| # | NAME     | HOUSE     |
++---+----------+-----------+
+| 1 | Arya     | Stark     |
+| 2 | Jon      | Snow      |
+| 3 | Tyrion   | Lannister |
+| 4 | Sansa    | Stark     |
+| 5 | Bran     | Stark     |
++---+----------+-----------+
+|   | TOTAL    | 8         |
++---+----------+-----------+
+lines 1-5 of 8 | column 1/3: #`)
+	})
+
+	t.Run("search with colors", func(t *testing.T) {
+		tw := newTable()
+		tw.SetColumnConfigs([]ColumnConfig{{Name: "House", Colors: text.Colors{text.FgRed}}})
+
+		// the color codes are neither searched nor broken up
+		compareOutput(t, view(t, tw, "/3\r"), "\n"+
+			"+---+----------+-----------+\n"+
+			"| # | NAME     | HOUSE     |\n"+
+			"+---+----------+-----------+\n"+
+			"| {3} | Tyrion   |\x1b[31m Lannister \x1b[0m|\n"+
+			"| 4 | Sansa    |\x1b[31m Stark     \x1b[0m|\n"+
+			"| 5 | Bran     |\x1b[31m Stark     \x1b[0m|\n"+
+			"| 6 | Cersei   |\x1b[31m Lannister \x1b[0m|\n"+
+			"| 7 | Daenerys |\x1b[31m Targaryen \x1b[0m|\n"+
+			"+---+----------+-----------+\n"+
+			"|   | TOTAL    | 8         |\n"+
+			"+---+----------+-----------+\n"+
+			"lines 3-7 of 8 | column 1/3: # | /3: 1 matches")
+		// a match across the color codes is highlighted around them
+		compareOutput(t, view(t, tw, "/r |\r"), "\n"+
+			"+---+----------+-----------+\n"+
+			"| # | NAME     | HOUSE     |\n"+
+			"+---+----------+-----------+\n"+
+			"| 3 | Tyrion   |\x1b[31m Lanniste{r }\x1b[0m{|}\n"+
+			"| 4 | Sansa    |\x1b[31m Stark     \x1b[0m|\n"+
+			"| 5 | Bran     |\x1b[31m Stark     \x1b[0m|\n"+
+			"| 6 | Cersei   |\x1b[31m Lanniste{r }\x1b[0m{|}\n"+
+			"| 7 | Daenerys |\x1b[31m Targaryen \x1b[0m|\n"+
+			"+---+----------+-----------+\n"+
+			"|   | TOTAL    | 8         |\n"+
+			"+---+----------+-----------+\n"+
+			"lines 3-7 of 8 | column 1/3: # | /r |: 3 matches")
+	})
+
+	t.Run("sort and hide", func(t *testing.T) {
+		tw := newTable()
+		tw.SetColumnConfigs([]ColumnConfig{{Name: "#", Hidden: true}})
+
+		compareOutput(t, view(t, tw, ">s"), `
++----------+-----------+
+| NAME     | HOUSE     |
++----------+-----------+
+| Arya     | Stark     |
+| Bran     | Stark     |
+| Cersei   | Lannister |
+| Daenerys | Targaryen |
+| Jaime    | Lannister |
++----------+-----------+
+| TOTAL    | 8         |
++----------+-----------+
+lines 1-5 of 8 | column 2/3: Name (sorted asc)`)
+		compareOutput(t, view(t, tw, "\x1b[C\x1b[Css"), `
++----------+-----------+
+| NAME     | HOUSE     |
++----------+-----------+
+| Daenerys | Targaryen |
+| Arya     | Stark     |
+| Sansa    | Stark     |
+| Bran     | Stark     |
+| Jon      | Snow      |
++----------+-----------+
+| TOTAL    | 8         |
++----------+-----------+
+lines 1-5 of 8 | column 3/3: House (sorted desc)`)
+		compareOutput(t, view(t, tw, ">>h<<h"), `
++---+----------+
+| # | NAME     |
++---+----------+
+| 1 | Arya     |
+| 2 | Jon      |
+| 3 | Tyrion   |
+| 4 | Sansa    |
+| 5 | Bran     |
++---+----------+
+|   | TOTAL    |
++---+----------+
+lines 1-5 of 8 | column 1/3: #`)
+		compareOutput(t, view(t, tw, ">>>hq"), `
++----------+
+| NAME     |
++----------+
+| Arya     |
+| Jon      |
+| Tyrion   |
+| Sansa    |
+| Bran     |
++----------+
+| TOTAL    |
++----------+
+lines 1-5 of 8 | column 3/3: House (hidden)`)
+
+		// the sorting and the column configs are restored on exit
+		compareOutput(t, tw.Render(), `
++----------+-----------+
+| NAME     | HOUSE     |
++----------+-----------+
+| Arya     | Stark     |
+| Jon      | Snow      |
+| Tyrion   | Lannister |
+| Sansa    | Stark     |
+| Bran     | Stark     |
+| Cersei   | Lannister |
+| Daenerys | Targaryen |
+| Jaime    | Lannister |
++----------+-----------+
+| TOTAL    | 8         |
++----------+-----------+`)
+	})
+
+	t.Run("unsupported writer", func(t *testing.T) {
+		var out strings.Builder
+		err := View(struct{ Writer }{}, strings.NewReader("q"), &out)
+		assert.Equal(t, ErrViewUnsupportedWriter, err)
+		assert.Empty(t, out.String())
+	})
+}
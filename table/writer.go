@@ -11,14 +11,24 @@ type Writer interface {
 	AppendRow(row Row, configs ...RowConfig)
 	AppendRows(rows []Row, configs ...RowConfig)
 	AppendSeparator()
+	AppendStructs(slice interface{}) bool
+	Filter(filter Filter)
 	FilterBy(filterBy []FilterBy)
+	GroupBy(groupBy []GroupBy)
+	ImportCSV(r io.Reader, opts CSVImportOptions) error
 	ImportGrid(grid interface{}) bool
 	Length() int
 	Pager(opts ...PagerOption) Pager
+	ParseFilter(expr string) (Filter, error)
 	Render() string
+	RenderAsciiDoc() string
 	RenderCSV() string
 	RenderHTML() string
+	RenderJSON() string
+	RenderLaTeX() string
 	RenderMarkdown() string
+	RenderNDJSON() string
+	RenderRST() string
 	RenderTSV() string
 	ResetFooters()
 	ResetHeaders()
@@ -148,6 +148,21 @@ func (c Color) CSSClasses() string {
 	return ""
 }
 
+// CSSStyle returns the inline CSS style for the color if it is a 256-color, as
+// there is no style sheet defining its CSS classes; returns "" for the other
+// colors.
+func (c Color) CSSStyle() string {
+	if c >= fg256Start && c < fg256Start+256 {
+		r, g, b := color256ToRGB(int(c - fg256Start))
+		return fmt.Sprintf("color:#%02x%02x%02x", r, g, b)
+	}
+	if c >= bg256Start && c < bg256Start+256 {
+		r, g, b := color256ToRGB(int(c - bg256Start))
+		return fmt.Sprintf("background-color:#%02x%02x%02x", r, g, b)
+	}
+	return ""
+}
+
 // EscapeSeq returns the ANSI escape sequence for the color.
 func (c Color) EscapeSeq() string {
 	// Check if it's a 256-color foreground (1000-1255)
@@ -331,6 +331,18 @@ func TestColor_CSSClasses_256Color(t *testing.T) {
 	assert.Equal(t, "bg-black", BgBlack.CSSClasses())
 }
 
+func TestColor_CSSStyle(t *testing.T) {
+	assert.Equal(t, "color:#000000", Fg256Color(0).CSSStyle())
+	assert.Equal(t, "color:#ff0000", Fg256Color(9).CSSStyle())
+	assert.Equal(t, "color:#99cc33", Fg256RGB(3, 4, 1).CSSStyle())
+	assert.Equal(t, "background-color:#080808", Bg256Color(232).CSSStyle())
+	assert.Equal(t, "background-color:#0000ff", Bg256RGB(0, 0, 5).CSSStyle())
+
+	// no inline style for the colors with CSS classes
+	assert.Equal(t, "", FgRed.CSSStyle())
+	assert.Equal(t, "", Bold.CSSStyle())
+}
+
 func TestColors_CSSClasses_256Color(t *testing.T) {
 	// Single 256-color
 	assert.Contains(t, Colors{Fg256Color(123)}.CSSClasses(), "fg-256-")
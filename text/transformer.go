@@ -147,17 +147,27 @@ func NewTimeTransformer(layout string, location *time.Location) Transformer {
 		}
 		// Only convert to string if not already time.Time
 		rsp := fmt.Sprint(val)
-		// Cycle through some supported layouts to see if the string form
-		// of the object matches any of these layouts
-		for _, possibleTimeLayout := range possibleTimeLayouts {
-			if valTime, err := time.Parse(possibleTimeLayout, rsp); err == nil {
-				return formatTime(valTime, layout, location)
-			}
+		if valTime, ok := ParseTime(rsp); ok {
+			return formatTime(valTime, layout, location)
 		}
 		return rsp
 	}
 }
 
+// ParseTime parses the string using the time layouts understood by
+// NewTimeTransformer (RFC3339 with or without fractional seconds) and returns
+// the time along with a bool telling if any of the layouts matched.
+func ParseTime(str string) (time.Time, bool) {
+	// Cycle through some supported layouts to see if the string matches any
+	// of these layouts
+	for _, possibleTimeLayout := range possibleTimeLayouts {
+		if valTime, err := time.Parse(possibleTimeLayout, str); err == nil {
+			return valTime, true
+		}
+	}
+	return time.Time{}, false
+}
+
 // NewUnixTimeTransformer returns a Transformer that can format a unix-timestamp
 // into a well-defined time format as defined by 'layout'. This can handle
 // unix-time in Seconds, MilliSeconds, Microseconds and Nanoseconds.
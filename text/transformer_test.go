@@ -201,6 +201,19 @@ func TestNewTimeTransformer(t *testing.T) {
 	assert.Equal(t, "2010-11-12T13:14:15-07:00", transformer(inTime))
 }
 
+func TestParseTime(t *testing.T) {
+	inTime := time.Date(2010, 11, 12, 13, 14, 15, 123456789, time.UTC)
+	for _, possibleTimeLayout := range possibleTimeLayouts {
+		valTime, ok := ParseTime(inTime.Format(possibleTimeLayout))
+		assert.True(t, ok, possibleTimeLayout)
+		assert.Equal(t, inTime.Truncate(time.Second), valTime.Truncate(time.Second), possibleTimeLayout)
+	}
+
+	valTime, ok := ParseTime("not a time string")
+	assert.False(t, ok)
+	assert.True(t, valTime.IsZero())
+}
+
 func TestNewUnixTimeTransformer(t *testing.T) {
 	inStr := "2010-11-12T13:14:15-07:00"
 	inTime, err := time.Parse(time.RFC3339, inStr)